@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goodhangul/hwp50"
+)
+
+// runInfo prints the properties in the FileHeader of the file. Only the
+// header is read so it works on encrypted files too.
+func runInfo(fs *flag.FlagSet, args []string) error {
+	output := fs.String("o", "", "write to `OUTPUT` instead of stdout")
+	asJSON := fs.Bool("json", false, "print as JSON")
+
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	fileName, err := oneFile(args)
+	if err != nil {
+		return err
+	}
+
+	fh, err := ParseHeader(fileName)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, func(w io.Writer) error {
+		summary := fh.Summary()
+		if *asJSON {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(summary)
+		}
+		_, err := io.WriteString(w, summary.String())
+		return err
+	})
+}
+
+// textFlags registers the flags that choose what text is extracted.
+func textFlags(fs *flag.FlagSet) *hwp50.TextOptions {
+	opts := hwp50.DefaultTextOptions
+	fs.BoolVar(&opts.Tables, "tables", opts.Tables, "include table cells")
+	fs.BoolVar(&opts.TextBoxes, "textboxes", opts.TextBoxes, "include text boxes")
+	fs.BoolVar(&opts.HeadersFooters, "headers", opts.HeadersFooters, "include headers and footers")
+	fs.BoolVar(&opts.Notes, "notes", opts.Notes, "include footnotes and endnotes")
+	fs.BoolVar(&opts.Numbers, "numbers", opts.Numbers, "put numbers and bullets before paragraphs")
+	return &opts
+}
+
+// runText prints the text of the file.
+func runText(fs *flag.FlagSet, args []string) error {
+	output := fs.String("o", "", "write to `OUTPUT` instead of stdout")
+	opts := textFlags(fs)
+
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	fileName, err := oneFile(args)
+	if err != nil {
+		return err
+	}
+
+	hwp, err := Parse(fileName)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, func(w io.Writer) error {
+		return hwp.WriteTextOptions(w, *opts)
+	})
+}
+
+// runConvert converts the file to another format.
+func runConvert(fs *flag.FlagSet, args []string) error {
+	output := fs.String("o", "", "write to `OUTPUT` instead of stdout")
+	format := fs.String("to", "", "convert to `FORMAT`: text, xml or pdf")
+	opts := textFlags(fs)
+
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	fileName, err := oneFile(args)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "text":
+	case "":
+		return usageError{errors.New("missing -to FORMAT")}
+	default:
+		return fmt.Errorf("%w %q", errUnsupported, *format)
+	}
+
+	hwp, err := Parse(fileName)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, func(w io.Writer) error {
+		return hwp.WriteTextOptions(w, *opts)
+	})
+}
+
+// runExtract writes a stream of the file, decompressed, or lists them. With
+// -bindata it writes every picture and OLE object into a directory instead.
+func runExtract(fs *flag.FlagSet, args []string) error {
+	output := fs.String("o", "", "write to `OUTPUT` instead of stdout")
+	list := fs.Bool("list", false, "list the streams of the file")
+	stream := fs.String("stream", "", "extract the stream at `NAME`, e.g. BodyText/Section0")
+	binData := fs.String("bindata", "", "write the pictures and OLE objects into `DIR`")
+
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	fileName, err := oneFile(args)
+	if err != nil {
+		return err
+	}
+	if !*list && *stream == "" && *binData == "" {
+		return usageError{errors.New("missing -list, -stream NAME or -bindata DIR")}
+	}
+
+	hwp, err := Parse(fileName)
+	if err != nil {
+		return err
+	}
+
+	if *binData != "" {
+		return extractBinData(hwp, *binData)
+	}
+
+	if *list {
+		return writeOutput(*output, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, strings.Join(hwp.Streams(), "\n"))
+			return err
+		})
+	}
+
+	rc, err := hwp.OpenStream(*stream)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return writeOutput(*output, func(w io.Writer) error {
+		_, err := io.Copy(w, rc)
+		return err
+	})
+}
+
+// extractBinData writes the data of every BinData of hwp into dir, named
+// after its id like "bin0001.jpg". Linked files aren't in the document and
+// are only reported. Items that can't be read are reported and skipped so
+// one bad item doesn't stop the rest.
+func extractBinData(hwp *hwp50.Hwp, dir string) error {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	// The error of the first item that failed is returned at the end.
+	var (
+		first  error
+		failed int
+	)
+	for i := range hwp.DocInfo.BinData {
+		id := i + 1
+		err := extractOneBinData(hwp, id, dir)
+		if errors.Is(err, hwp50.ErrLinkedBinData) {
+			fmt.Fprintf(os.Stderr, "bin data %d: %v\n", id, err)
+			continue
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "bin data %d: %v\n", id, err)
+			if first == nil {
+				first = err
+			}
+			failed++
+		}
+	}
+
+	if first != nil {
+		return fmt.Errorf("%d of %d bin data failed: %w", failed,
+			len(hwp.DocInfo.BinData), first)
+	}
+	return nil
+}
+
+// extractOneBinData writes the data of the BinData with the given id into
+// dir.
+func extractOneBinData(hwp *hwp50.Hwp, id int, dir string) error {
+	rc, ext, err := hwp.OpenBinData(id)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	name := fmt.Sprintf("bin%04d.%s", id, safeExtension(ext))
+	return writeOutput(filepath.Join(dir, name), func(w io.Writer) error {
+		_, err := io.Copy(w, rc)
+		return err
+	})
+}
+
+// safeExtension returns ext if it's made of lower case letters and digits
+// only, and "bin" otherwise. The extension comes from the document, so it
+// mustn't be able to point the file outside of the output directory.
+func safeExtension(ext string) string {
+	if ext == "" {
+		return "bin"
+	}
+	for _, c := range ext {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return "bin"
+		}
+	}
+	return ext
+}
+
+// runDump prints the record tree of a DocInfo or BodyText stream.
+func runDump(fs *flag.FlagSet, args []string) error {
+	output := fs.String("o", "", "write to `OUTPUT` instead of stdout")
+	stream := fs.String("stream", "DocInfo", "dump the stream at `NAME`, e.g. BodyText/Section0")
+	data := fs.Bool("data", false, "print the data of the records in hex")
+
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	fileName, err := oneFile(args)
+	if err != nil {
+		return err
+	}
+
+	hwp, err := Parse(fileName)
+	if err != nil {
+		return err
+	}
+
+	rc, err := hwp.OpenStream(*stream)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	roots, err := hwp50.ReadRecordTree(rc)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(*output, func(w io.Writer) error {
+		for _, root := range roots {
+			err := root.Walk(func(node *hwp50.RecordNode, depth int) error {
+				_, err := fmt.Fprintf(w, "%s%v level=%d size=%d offset=%d\n",
+					strings.Repeat("  ", depth), node.TagID, node.Level,
+					node.Size, node.Offset)
+				if err == nil && *data && len(node.Data) > 0 {
+					_, err = fmt.Fprintf(w, "%s  % x\n",
+						strings.Repeat("  ", depth), node.Data)
+				}
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// runValidate parses every file given and reports the ones that fail.
+func runValidate(fs *flag.FlagSet, args []string) error {
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return usageError{errors.New("expected at least one FILE")}
+	}
+
+	// The error of the first file that failed decides the exit code.
+	var (
+		first  error
+		failed int
+	)
+	for _, fileName := range args {
+		_, err := Parse(fileName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", fileName, err)
+			if first == nil {
+				first = err
+			}
+			failed++
+			continue
+		}
+		fmt.Printf("%s: ok\n", fileName)
+	}
+
+	if first != nil {
+		return fmt.Errorf("%d of %d files failed: %w", failed, len(args), first)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package hwp50
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// BinDataType is where the data of a BinData is kept.
+//
+// BinDataType은 BinData의 데이터가 있는 곳입니다.
+type BinDataType uint8
+
+const (
+	// BinDataLink is a file outside of the document.
+	BinDataLink BinDataType = iota
+
+	// BinDataEmbedding is a stream of the BinData storage.
+	BinDataEmbedding
+
+	// BinDataStorage is an OLE object in the BinData storage.
+	BinDataStorage
+)
+
+// BinData stores data about pictures, OLE, etc.
+//
+// BinData는 그림, OLE 개체 등의 바이너리 데이터 정보를 담고 있습니다.
+type BinData struct {
+	// Stores information on whehter BinData has compression,
+	// images, and whether it was accssed before
+	Property uint16
+
+	// AbsolutePath and RelativePath are the paths of the linked file.
+	// Only relevant when Type is BinDataLink
+	AbsolutePath string
+	RelativePath string
+
+	// ID is the number in the name of the stream in the BinData storage,
+	// e.g. 1 for "BIN0001.jpg".
+	// Only relevant when Type is BinDataEmbedding or BinDataStorage
+	ID uint16
+
+	// Extension is the format of the data without the dot, e.g. "jpg",
+	// "bmp", "gif" or "OLE".
+	// Only relevant when Type is BinDataEmbedding or BinDataStorage
+	Extension string
+}
+
+// Type returns bits 0~3
+func (bd *BinData) Type() BinDataType {
+	return BinDataType(bd.Property & 15)
+}
+
+func (bd *BinData) HasExternalPicture() bool {
+	return bd.Type() == BinDataLink
+}
+
+func (bd *BinData) HasEmbeddedPicture() bool {
+	return bd.Type() == BinDataEmbedding
+}
+
+func (bd *BinData) HasStorage() bool {
+	return bd.Type() == BinDataStorage
+}
+
+func (bd *BinData) IsDefultStorageMode() bool {
+	// take only the bits 4~5
+	value := (bd.Property >> 4) & 3
+
+	return value == 0
+}
+
+func (bd *BinData) AlwaysCompress() bool {
+	// take only the bits 4~5
+	value := (bd.Property >> 4) & 3
+
+	return value == 1
+}
+
+func (bd *BinData) NeverCompress() bool {
+	// take only the bits 4~5
+	value := (bd.Property >> 4) & 3
+
+	return value == 2
+}
+
+func (bd *BinData) NeverAccessed() bool {
+	// take only the bits 8~9
+	value := (bd.Property >> 8) & 3
+
+	return value == 0
+}
+
+func (bd *BinData) AccessSucessful() bool {
+	// take only the bits 8~9
+	value := (bd.Property >> 8) & 3
+
+	return value == 1
+}
+
+func (bd *BinData) AccessFailed() bool {
+	// take only the bits 8~9
+	value := (bd.Property >> 8) & 3
+
+	return value == 2
+}
+
+func (bd *BinData) AccessFailedAndErrorIgnored() bool {
+	// take only the bits 8~9
+	value := (bd.Property >> 8) & 3
+
+	return value == 3
+}
+
+// IsCompressed reports if the stream of bd is compressed. In the default
+// storage mode it follows the compression flag of the FileHeader.
+//
+// IsCompressed는 bd의 스트림이 압축되었는지를 뜻합니다. 기본 모드에서는
+// FileHeader의 압축 속성을 따릅니다.
+func (bd *BinData) IsCompressed(fh *FileHeader) bool {
+	switch {
+	case bd.AlwaysCompress():
+		return true
+	case bd.NeverCompress():
+		return false
+	}
+	return fh.Fp.IsCompressed()
+}
+
+// StreamName returns the name of the stream of bd in the BinData storage,
+// e.g. "BIN0001.jpg", or "" for a linked file.
+//
+// StreamName은 BinData 스토리지에서 bd의 스트림 이름을 반환합니다(예:
+// "BIN0001.jpg"). 외부 파일이면 ""를 반환합니다.
+func (bd *BinData) StreamName() string {
+	if bd.Type() == BinDataLink {
+		return ""
+	}
+	return fmt.Sprintf("BIN%04X.%s", bd.ID, bd.Extension)
+}
+
+func (bd *BinData) deserialize(data []byte) error {
+	d := newDataReader(data)
+	bd.Property = d.uint16()
+
+	switch bd.Type() {
+	case BinDataLink:
+		bd.AbsolutePath = d.string()
+		bd.RelativePath = d.string()
+	case BinDataEmbedding, BinDataStorage:
+		bd.ID = d.uint16()
+		// The spec only gives embeddings an extension but OLE objects
+		// have one too.
+		if d.remaining() >= 2 {
+			bd.Extension = d.string()
+		}
+	}
+
+	return d.err
+}
+
+func decodeBinData(rec *Record, ver FileVersion) (interface{}, error) {
+	bd := new(BinData)
+	err := bd.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return bd, nil
+}
+
+func init() {
+	registerDecoder(TagBinData, decodeBinData)
+}
+
+// ErrLinkedBinData is returned when opening a BinData whose data is a file
+// outside of the document.
+//
+// ErrLinkedBinData는 데이터가 문서 밖의 파일인 BinData를 열 때
+// 반환됩니다.
+var ErrLinkedBinData = errors.New("linked bin data 외부 파일로 연결된 바이너리 데이터입니다")
+
+// OpenBinData opens the data of the BinData with the given id, starting at
+// 1 like the ids of pictures, and returns it with its extension in lower
+// case. The data is inflated as the compression flags of the BinData say.
+//
+// OpenBinData는 주어진 id(그림의 id처럼 1부터 시작)의 BinData 데이터를
+// 열고 소문자 확장자와 함께 반환합니다. 압축은 BinData의 압축 속성에
+// 따라 풉니다.
+func (hwp *Hwp) OpenBinData(id int) (io.ReadCloser, string, error) {
+	if id < 1 || id > len(hwp.DocInfo.BinData) {
+		return nil, "", fmt.Errorf("%w: bin data %d", ErrInvalidID, id)
+	}
+	bd := &hwp.DocInfo.BinData[id-1]
+	if bd.Type() == BinDataLink {
+		return nil, "", fmt.Errorf("%w: %s", ErrLinkedBinData, bd.AbsolutePath)
+	}
+
+	rc, err := hwp.OpenBinDataStream(bd, hwp.binDataStreamName(bd))
+	if err != nil {
+		return nil, "", err
+	}
+	return rc, strings.ToLower(bd.Extension), nil
+}
+
+// binDataStreamName returns the name of the stream of bd as it's stored.
+// Writers don't agree on the case of the extension, so the stream is
+// looked up ignoring case when the exact name isn't there.
+func (hwp *Hwp) binDataStreamName(bd *BinData) string {
+	name := bd.StreamName()
+	if _, ok := hwp.BinData[name]; ok {
+		return name
+	}
+	for stream := range hwp.BinData {
+		if strings.EqualFold(stream, name) {
+			return stream
+		}
+	}
+	return name
+}
+
+// binDataOfStream returns the BinData whose stream in the BinData storage
+// is name, ignoring the case of the extension like binDataStreamName, or
+// nil if there's none.
+func (hwp *Hwp) binDataOfStream(name string) *BinData {
+	for i := range hwp.DocInfo.BinData {
+		bd := &hwp.DocInfo.BinData[i]
+		if bd.Type() != BinDataLink && strings.EqualFold(bd.StreamName(), name) {
+			return bd
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package hwp50
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"io"
+	"testing"
+)
+
+// binDataRecord returns the data of a BIN_DATA record.
+func binDataRecord(property uint16, values ...interface{}) []byte {
+	var b []byte
+	b = append(b, littleEndian(property)...)
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			v = bstr(s)
+		}
+		b = append(b, littleEndian(v)...)
+	}
+	return b
+}
+
+// TestDecodeBinData decodes linked, embedded and OLE bin data.
+//
+// TestDecodeBinData는 연결, 포함, OLE 바이너리 데이터를 읽습니다.
+func TestDecodeBinData(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want BinData
+		name string
+	}{
+		{
+			data: binDataRecord(0, `C:\a.png`, "a.png"),
+			want: BinData{AbsolutePath: `C:\a.png`, RelativePath: "a.png"},
+		},
+		{
+			data: binDataRecord(0, `C:\문서\사진.png`, `사진.png`),
+			want: BinData{AbsolutePath: `C:\문서\사진.png`, RelativePath: `사진.png`},
+		},
+		{
+			data: binDataRecord(0x101, uint16(10), "jpg"),
+			want: BinData{Property: 0x101, ID: 10, Extension: "jpg"},
+			name: "BIN000A.jpg",
+		},
+		{
+			data: binDataRecord(0x22, uint16(2), "OLE"),
+			want: BinData{Property: 0x22, ID: 2, Extension: "OLE"},
+			name: "BIN0002.OLE",
+		},
+		{
+			data: binDataRecord(2, uint16(3)),
+			want: BinData{Property: 2, ID: 3},
+			name: "BIN0003.",
+		},
+	}
+	for _, test := range tests {
+		var bd BinData
+		err := bd.deserialize(test.data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bd != test.want {
+			t.Errorf("expected %+v, got %+v", test.want, bd)
+		}
+		if bd.StreamName() != test.name {
+			t.Errorf("expected stream %q, got %q", test.name, bd.StreamName())
+		}
+	}
+
+	var bd BinData
+	err := bd.deserialize(binDataRecord(1, uint16(1), uint16(3), uint16('j')))
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
+
+// TestOpenBinData opens bin data following their compression flags.
+//
+// TestOpenBinData는 압축 속성에 따라 바이너리 데이터를 엽니다.
+func TestOpenBinData(t *testing.T) {
+	picture := []byte("\xff\xd8\xff\xe0 not really a jpeg")
+
+	var compressed bytes.Buffer
+	fw, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw.Write(picture)
+	fw.Close()
+
+	hwp := &Hwp{
+		FileHeader: FileHeader{Fp: FpCompressed},
+		DocInfo: DocInfo{BinData: []BinData{
+			{Property: 1, ID: 1, Extension: "JPG"},
+			{Property: 0x21, ID: 2, Extension: "png"},
+			{Property: 0, AbsolutePath: `C:\a.png`},
+			{Property: 1, ID: 4, Extension: "gif"},
+		}},
+		BinData: map[string][]byte{
+			"BIN0001.jpg": compressed.Bytes(),
+			"BIN0002.png": picture,
+		},
+	}
+	hwp.streams = map[string][]byte{
+		"BinData/BIN0001.jpg": compressed.Bytes(),
+		"BinData/BIN0002.png": picture,
+	}
+
+	for id, want := range map[int]string{1: "jpg", 2: "png"} {
+		rc, ext, err := hwp.OpenBinData(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ext != want || !bytes.Equal(data, picture) {
+			t.Errorf("bin data %d: unexpected %q %q", id, ext, data)
+		}
+	}
+
+	// OpenStream follows the BinData too, so the NeverCompress picture
+	// isn't inflated although the document is compressed.
+	for _, name := range []string{"BinData/BIN0001.jpg", "BinData/BIN0002.png"} {
+		rc, err := hwp.OpenStream(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, picture) {
+			t.Errorf("%s: unexpected %q", name, data)
+		}
+	}
+
+	_, _, err = hwp.OpenBinData(3)
+	if !errors.Is(err, ErrLinkedBinData) {
+		t.Errorf("expected ErrLinkedBinData, got %v", err)
+	}
+	_, _, err = hwp.OpenBinData(4)
+	if !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("expected ErrStreamNotFound, got %v", err)
+	}
+	_, _, err = hwp.OpenBinData(0)
+	if !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+}
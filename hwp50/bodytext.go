@@ -45,12 +45,8 @@ const (
 // BodyText is the main stream of bytes for an hwp file. Includes information
 // about charts, images, etc.
 type BodyText struct {
-	ParaHeader              ParaHeader
-	ParaChar                []wchar
-	ParaCharShape           []paraCharShape
 	ParaLineSeg             paraLineSeg
 	ParaRangeTag            []byte
-	CtrlHeader              [4]byte
 	ListHeader              [6]byte
 	PageDef                 [40]byte
 	FootnoteShape           [30]byte
@@ -74,24 +70,52 @@ type BodyText struct {
 	ChartData               [2]byte
 	VideaData               []byte
 	ShapeComponentUnknown   [36]byte
+
+	// Paragraphs are the top level paragraphs of the section in order.
+	//
+	// Paragraphs는 구역의 최상위 문단들을 순서대로 담고 있습니다.
+	Paragraphs []*Paragraph
+
+	// nodes are the record trees of the section's stream. Each root is
+	// the PARA_HEADER of a paragraph.
+	nodes []*RecordNode
 }
 
+// ParaHeader is the header every paragraph starts with (HWPTAG_PARA_HEADER).
+//
+// ParaHeader는 모든 문단이 시작하는 헤더입니다 (HWPTAG_PARA_HEADER).
 type ParaHeader struct {
 	// NChars is the number of chars in this paragraph
 	NChars uint32
 
+	// LastInList is the highest bit of nChars in the record. The spec
+	// only says it's there for ease of implementation but it's set on
+	// the last paragraph of a list.
+	//
+	// LastInList는 레코드의 nChars의 가장 높은 비트입니다. 스펙에는
+	// 구현 편의상이라고만 나와 있지만 리스트의 마지막 문단에
+	// 켜져 있습니다.
+	LastInList bool
+
+	// ControlMask has the bit (1 << ch) set for every control char ch
+	// used in the paragraph.
 	ControlMask uint32
 
 	ParaShapeID uint16
 
 	ParaStyleID uint8
 
+	// SecSplitInfo tells what kind of break comes before the paragraph.
+	// See the break constants.
 	SecSplitInfo uint8
 
+	// CharShapeInfo is the number of PARA_CHAR_SHAPE entries
 	CharShapeInfo uint16
 
+	// RangeTagInfo is the number of PARA_RANGE_TAG entries
 	RangeTagInfo uint16
 
+	// LineAlignInfo is the number of PARA_LINE_SEG entries
 	LineAlignInfo uint16
 
 	SectionInsID uint32
@@ -100,12 +124,188 @@ type ParaHeader struct {
 	TrackChange uint16
 }
 
-// paraCharShape determines the shape of a char
-type paraCharShape struct {
-	// pos is the count where the CharShape changes
-	pos uint32
-	// shapeID is the shape of the ParaChar
-	shapeID uint32
+// Bits of ParaHeader.SecSplitInfo
+const (
+	breakSection = 1 << iota
+	breakMultiColumn
+	breakPage
+	breakColumn
+)
+
+// IsSectionBreak reports if a new section starts with the paragraph.
+//
+// IsSectionBreak는 문단이 새 구역을 시작하는지를 뜻합니다.
+func (ph *ParaHeader) IsSectionBreak() bool {
+	return ph.SecSplitInfo&breakSection != 0
+}
+
+// IsMultiColumnBreak reports if a new multi column layout starts with the
+// paragraph.
+//
+// IsMultiColumnBreak는 문단이 새 다단을 시작하는지를 뜻합니다.
+func (ph *ParaHeader) IsMultiColumnBreak() bool {
+	return ph.SecSplitInfo&breakMultiColumn != 0
+}
+
+// IsPageBreak reports if the paragraph starts on a new page.
+//
+// IsPageBreak는 문단이 새 쪽에서 시작하는지를 뜻합니다.
+func (ph *ParaHeader) IsPageBreak() bool {
+	return ph.SecSplitInfo&breakPage != 0
+}
+
+// IsColumnBreak reports if the paragraph starts on a new column.
+//
+// IsColumnBreak는 문단이 새 단에서 시작하는지를 뜻합니다.
+func (ph *ParaHeader) IsColumnBreak() bool {
+	return ph.SecSplitInfo&breakColumn != 0
+}
+
+// HasCtrlChar reports if the control char ch is used in the paragraph.
+//
+// HasCtrlChar는 문단에 제어 문자 ch가 쓰였는지를 뜻합니다.
+func (ph *ParaHeader) HasCtrlChar(ch wchar) bool {
+	return ch < 32 && ph.ControlMask&(1<<ch) != 0
+}
+
+// deserialize reads the header from the data of a HWPTAG_PARA_HEADER
+// record. TrackChange is only read for files of v5.0.3.2 and up.
+func (ph *ParaHeader) deserialize(data []byte, ver FileVersion) error {
+	d := newDataReader(data)
+
+	nChars := d.uint32()
+	ph.NChars = nChars &^ (1 << badEngineeringCoveredWithAnExcuse)
+	ph.LastInList = nChars&(1<<badEngineeringCoveredWithAnExcuse) != 0
+
+	ph.ControlMask = d.uint32()
+	ph.ParaShapeID = d.uint16()
+	ph.ParaStyleID = d.uint8()
+	ph.SecSplitInfo = d.uint8()
+	ph.CharShapeInfo = d.uint16()
+	ph.RangeTagInfo = d.uint16()
+	ph.LineAlignInfo = d.uint16()
+	ph.SectionInsID = d.uint32()
+
+	if ver.AtLeast(5, 0, 3, 2) && d.remaining() >= 2 {
+		ph.TrackChange = d.uint16()
+	}
+
+	return d.err
+}
+
+func decodeParaHeader(rec *Record, ver FileVersion) (interface{}, error) {
+	ph := new(ParaHeader)
+	err := ph.deserialize(rec.Data, ver)
+	if err != nil {
+		return nil, err
+	}
+	return ph, nil
+}
+
+func init() {
+	registerDecoder(TagParaHeader, decodeParaHeader)
+	registerDecoder(TagParaCharShape, decodeParaCharShape)
+}
+
+// Paragraph is a paragraph along with the records it's made of.
+//
+// Paragraph는 문단과 문단을 이루는 레코드들입니다.
+type Paragraph struct {
+	Header ParaHeader
+
+	// Runs is the text of the paragraph split into plain text and
+	// control chars.
+	//
+	// Runs는 일반 텍스트와 제어 문자로 나뉜 문단의 텍스트입니다.
+	Runs []TextRun
+
+	// CharShapes are where the char shape of the paragraph changes,
+	// ordered by position.
+	//
+	// CharShapes는 문단의 글자 모양이 바뀌는 위치들입니다.
+	CharShapes []ParaCharShape
+
+	// node is the PARA_HEADER record of the paragraph. Its children are
+	// the rest of the paragraph's records.
+	node *RecordNode
+}
+
+// newParagraph decodes the paragraph starting at the PARA_HEADER node.
+func newParagraph(node *RecordNode, ver FileVersion) (*Paragraph, error) {
+	v, err := DecodeRecord(node.Record, ver)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Paragraph{
+		Header: *v.(*ParaHeader),
+		node:   node,
+	}
+
+	// Paragraphs without text have no PARA_TEXT.
+	// 텍스트가 없는 문단은 PARA_TEXT가 없습니다.
+	if text := node.Child(TagParaText); text != nil {
+		v, err = DecodeRecord(text.Record, ver)
+		if err != nil {
+			return nil, err
+		}
+		p.Runs = v.([]TextRun)
+		linkControls(p.Runs, node.ChildrenOf(TagCtrlHeader), ver)
+	}
+
+	if shapes := node.Child(TagParaCharShape); shapes != nil {
+		v, err = DecodeRecord(shapes.Record, ver)
+		if err != nil {
+			return nil, err
+		}
+		p.CharShapes = v.([]ParaCharShape)
+	}
+
+	return p, nil
+}
+
+// paragraphs decodes every PARA_HEADER node in nodes.
+func paragraphs(nodes []*RecordNode, ver FileVersion) ([]*Paragraph, error) {
+	var paras []*Paragraph
+	for _, node := range nodes {
+		if node.TagID != TagParaHeader {
+			continue
+		}
+		p, err := newParagraph(node, ver)
+		if err != nil {
+			return nil, err
+		}
+		paras = append(paras, p)
+	}
+	return paras, nil
+}
+
+// ParaCharShape determines the shape of a char (HWPTAG_PARA_CHAR_SHAPE).
+//
+// ParaCharShape는 글자의 모양을 정합니다 (HWPTAG_PARA_CHAR_SHAPE).
+type ParaCharShape struct {
+	// Pos is the count where the CharShape changes
+	Pos uint32
+	// ShapeID is the shape of the ParaChar
+	ShapeID uint32
+}
+
+// decodeParaCharShape reads the (position, char shape id) pairs of a
+// paragraph.
+func decodeParaCharShape(rec *Record, ver FileVersion) (interface{}, error) {
+	d := newDataReader(rec.Data)
+
+	// Round up so a cut off pair is reported as truncated.
+	// 잘린 쌍이 있으면 잘림으로 알리도록 올림합니다.
+	shapes := make([]ParaCharShape, (len(rec.Data)+7)/8)
+	for i := range shapes {
+		shapes[i].Pos = d.uint32()
+		shapes[i].ShapeID = d.uint32()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return shapes, nil
 }
 
 type paraLineSeg struct {
@@ -0,0 +1,71 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// TestParaHeaderTestdata checks the header of the only paragraph of the
+// testdata file.
+//
+// TestParaHeaderTestdata는 testdata 파일의 유일한 문단의 헤더를
+// 확인합니다.
+func TestParaHeaderTestdata(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paras := hwp.BodyText[0].Paragraphs
+	if len(paras) != 1 {
+		t.Fatalf("expected 1 paragraph, got %d", len(paras))
+	}
+
+	ph := paras[0].Header
+	if ph.NChars != 21 || !ph.LastInList {
+		t.Errorf("expected 21 chars in the last paragraph, got %d %v",
+			ph.NChars, ph.LastInList)
+	}
+	if !ph.HasCtrlChar(2) || ph.HasCtrlChar(3) {
+		t.Errorf("unexpected control mask %x", ph.ControlMask)
+	}
+	if !ph.IsSectionBreak() || !ph.IsMultiColumnBreak() || ph.IsPageBreak() {
+		t.Errorf("unexpected breaks %x", ph.SecSplitInfo)
+	}
+	if ph.ParaShapeID != 3 || ph.CharShapeInfo != 1 || ph.LineAlignInfo != 1 {
+		t.Errorf("unexpected header %+v", ph)
+	}
+}
+
+// TestParaHeaderTrackChange checks that TrackChange is only read from
+// v5.0.3.2 on.
+//
+// TestParaHeaderTrackChange는 TrackChange가 v5.0.3.2부터만 읽히는지
+// 확인합니다.
+func TestParaHeaderTrackChange(t *testing.T) {
+	data := make([]byte, 24)
+	binary.LittleEndian.PutUint32(data, 5)
+	binary.LittleEndian.PutUint16(data[22:], 7)
+
+	var ph ParaHeader
+	err := ph.deserialize(data, FileVersion{5, 0, 3, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ph.TrackChange != 0 || ph.NChars != 5 || ph.LastInList {
+		t.Errorf("unexpected header for v5.0.3.1 %+v", ph)
+	}
+
+	err = ph.deserialize(data, FileVersion{5, 0, 3, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ph.TrackChange != 7 {
+		t.Errorf("expected TrackChange 7, got %d", ph.TrackChange)
+	}
+
+	err = ph.deserialize(data[:20], FileVersion{5, 0, 3, 2})
+	if err == nil {
+		t.Error("expected an error for a short record")
+	}
+}
@@ -0,0 +1,402 @@
+package hwp50
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// ColorRef is a color stored as 0x00BBGGRR.
+//
+// ColorRef는 0x00BBGGRR 형식으로 저장된 색입니다.
+type ColorRef uint32
+
+// ColorNone is used where no color is set, e.g. a transparent background.
+//
+// ColorNone은 색이 없을 때(예: 투명한 배경) 쓰입니다.
+const ColorNone ColorRef = 0xFFFFFFFF
+
+func (c ColorRef) R() uint8 { return uint8(c) }
+func (c ColorRef) G() uint8 { return uint8(c >> 8) }
+func (c ColorRef) B() uint8 { return uint8(c >> 16) }
+
+// String returns the color as "#rrggbb", or "none" for ColorNone.
+func (c ColorRef) String() string {
+	if c == ColorNone {
+		return "none"
+	}
+	return fmt.Sprintf("#%02x%02x%02x", c.R(), c.G(), c.B())
+}
+
+// LineType is the kind of line a border or an underline is drawn with.
+// The spec's table starts at solid but files use 0 for no line.
+//
+// LineType은 테두리나 밑줄을 그리는 선의 종류입니다.
+type LineType uint8
+
+const (
+	LineNone LineType = iota
+	LineSolid
+	LineDash
+	LineDot
+	LineDashDot
+	LineDashDotDot
+	LineLongDash
+	LineCircle
+	LineDouble
+	LineThinThick
+	LineThickThin
+	LineThinThickThin
+	LineWave
+	LineDoubleWave
+	LineThick3D
+	LineThick3DInset
+	Line3D
+	Line3DInset
+)
+
+var lineTypeNames = []string{
+	"none", "solid", "dash", "dot", "dashDot", "dashDotDot", "longDash",
+	"circle", "double", "thinThick", "thickThin", "thinThickThin", "wave",
+	"doubleWave", "thick3D", "thick3DInset", "3D", "3DInset",
+}
+
+func (t LineType) String() string {
+	if int(t) < len(lineTypeNames) {
+		return lineTypeNames[t]
+	}
+	return fmt.Sprintf("LineType(%d)", uint8(t))
+}
+
+// LineThickness is the index of a line thickness in the spec's table.
+//
+// LineThickness는 선 굵기 표의 순번입니다.
+type LineThickness uint8
+
+var lineThicknesses = []float32{
+	0.1, 0.12, 0.15, 0.2, 0.25, 0.3, 0.4, 0.5,
+	0.6, 0.7, 1.0, 1.5, 2.0, 3.0, 4.0, 5.0,
+}
+
+// Millimeters returns the thickness in millimeters.
+//
+// Millimeters는 굵기를 밀리미터로 반환합니다.
+func (t LineThickness) Millimeters() (float32, error) {
+	if int(t) >= len(lineThicknesses) {
+		return 0, fmt.Errorf("unknown line thickness %d", t)
+	}
+	return lineThicknesses[t], nil
+}
+
+// Border is one line of a BorderFill.
+//
+// Border는 BorderFill의 선 하나입니다.
+type Border struct {
+	Type      LineType
+	Thickness LineThickness
+	Color     ColorRef
+}
+
+// Sides of a BorderFill, in the order they're stored.
+//
+// BorderFill의 각 변으로, 저장된 순서입니다.
+const (
+	BorderLeft = iota
+	BorderRight
+	BorderTop
+	BorderBottom
+)
+
+// BorderFill is a set of borders and a background shared by table cells,
+// paragraphs and pages.
+//
+// BorderFill은 표의 셀, 문단, 쪽이 같이 쓰는 테두리와 배경입니다.
+type BorderFill struct {
+	// Implements "Chart 24" in the hwp50 specs
+	Property uint16
+
+	// Implements "Chart 25" and "Chart 26" in the hwp50 specs, indexed by
+	// BorderLeft, BorderRight, BorderTop and BorderBottom.
+	Borders [4]Border
+
+	// Implements "Chart 27" in the hwp50 specs
+	Diagonal Border
+
+	// Implements "Chart 28" in the hwp50 specs
+	Fill Fill
+}
+
+func (bf *BorderFill) Has3DEffect() bool {
+	return bf.Property&(1<<0) != 0
+}
+
+func (bf *BorderFill) HasShadows() bool {
+	return bf.Property&(1<<1) != 0
+}
+
+// GetSlashShape returns bits 2~4
+func (bf *BorderFill) GetSlashShape() uint16 {
+	return (bf.Property >> 2) & 7
+}
+
+// GetBackSlashShape returns bits 5~7
+func (bf *BorderFill) GetBackSlashShape() uint16 {
+	return (bf.Property >> 5) & 7
+}
+
+// IsSlashBent returns bits 8~9
+func (bf *BorderFill) IsSlashBent() uint16 {
+	return (bf.Property >> 8) & 3
+}
+
+func (bf *BorderFill) IsBackSlashBent() bool {
+	return bf.Property&(1<<10) != 0
+}
+
+func (bf *BorderFill) IsSlash180flipped() bool {
+	return bf.Property&(1<<11) != 0
+}
+
+func (bf *BorderFill) IsBackSlash180flipped() bool {
+	return bf.Property&(1<<12) != 0
+}
+
+func (bf *BorderFill) HasCenterLine() bool {
+	return bf.Property&(1<<13) != 0
+}
+
+// FillType tells which fills a Fill holds. The kinds can be combined.
+//
+// FillType은 Fill이 담은 채우기 종류입니다. 여러 종류가 같이 올 수
+// 있습니다.
+type FillType uint32
+
+const (
+	FillNone     FillType = 0
+	FillSolid    FillType = 1 << 0
+	FillImage    FillType = 1 << 1
+	FillGradient FillType = 1 << 2
+)
+
+// HatchType is the pattern drawn over a solid fill.
+//
+// HatchType은 단색 채우기 위에 그리는 무늬입니다.
+type HatchType int32
+
+const (
+	HatchHorizontal HatchType = iota
+	HatchVertical
+	HatchBackSlash
+	HatchSlash
+	HatchCross
+	HatchCrossDiagonal
+
+	HatchNone HatchType = -1
+)
+
+// GradientType is the shape of a gradient.
+//
+// GradientType은 그러데이션의 모양입니다.
+type GradientType int8
+
+const (
+	GradientLinear GradientType = iota + 1
+	GradientRadial
+	GradientConical
+	GradientSquare
+)
+
+// ImageFillType is how an image is laid out in the area it fills.
+//
+// ImageFillType은 그림을 채우는 방식입니다.
+type ImageFillType uint8
+
+const (
+	ImageTile ImageFillType = iota
+	ImageTileTop
+	ImageTileBottom
+	ImageTileLeft
+	ImageTileRight
+	ImageStretch
+	ImageCenter
+	ImageCenterTop
+	ImageCenterBottom
+	ImageLeftCenter
+	ImageLeftTop
+	ImageLeftBottom
+	ImageRightCenter
+	ImageRightTop
+	ImageRightBottom
+	ImageZoom
+)
+
+// ImageEffect is the effect applied to an image.
+//
+// ImageEffect는 그림에 적용한 효과입니다.
+type ImageEffect uint8
+
+const (
+	ImageOriginal ImageEffect = iota
+	ImageGrayscale
+	ImageBlackWhite
+	ImagePattern
+)
+
+// SolidFill is a background color with an optional hatch.
+//
+// SolidFill은 배경색과 무늬입니다.
+type SolidFill struct {
+	Background   ColorRef
+	PatternColor ColorRef
+	Hatch        HatchType
+}
+
+// GradientFill is a gradient between two or more colors.
+//
+// GradientFill은 두 개 이상의 색 사이의 그러데이션입니다.
+type GradientFill struct {
+	Type GradientType
+
+	// Angle is the slant of the gradient in degrees.
+	Angle int32
+
+	// CenterX and CenterY are the center in percent of the area.
+	CenterX, CenterY int32
+
+	// Blur is how much the colors spread.
+	Blur int32
+
+	// Positions are where each color starts. They're only stored when
+	// there are more than two colors.
+	Positions []int32
+
+	Colors []ColorRef
+
+	// BlurCenter is the center of the blur, from 0 to 100.
+	BlurCenter uint8
+}
+
+// ImageFill is an image from the BinData storage.
+//
+// ImageFill은 BinData 스토리지의 그림입니다.
+type ImageFill struct {
+	Type       ImageFillType
+	Brightness int8
+	Contrast   int8
+	Effect     ImageEffect
+
+	// BinItem is the id of the BinData, starting at 1.
+	BinItem uint16
+}
+
+// Fill is the background of a BorderFill.
+//
+// Fill은 BorderFill의 배경입니다.
+type Fill struct {
+	Type FillType
+
+	// Only set when Type has the matching kind.
+	Solid    SolidFill
+	Gradient GradientFill
+	Image    ImageFill
+
+	// Undefined holds the bytes the spec leaves undefined, one for each
+	// kind set in Type.
+	Undefined []byte
+}
+
+// Has reports if every kind of t is in f.
+func (f *Fill) Has(t FillType) bool {
+	return f.Type&t == t
+}
+
+func (f *Fill) deserialize(d *dataReader) {
+	f.Type = FillType(d.uint32())
+
+	if f.Has(FillSolid) {
+		f.Solid.Background = ColorRef(d.uint32())
+		f.Solid.PatternColor = ColorRef(d.uint32())
+		f.Solid.Hatch = HatchType(d.int32())
+	}
+
+	// The spec lists the gradient fields as INT16 but files store a BYTE
+	// type followed by INT32s.
+	if f.Has(FillGradient) {
+		g := &f.Gradient
+		g.Type = GradientType(d.int8())
+		g.Angle = d.int32()
+		g.CenterX = d.int32()
+		g.CenterY = d.int32()
+		g.Blur = d.int32()
+		n := int(d.int32())
+		if n < 0 || n > d.remaining()/4 {
+			// Let the reader report the bad count as truncated.
+			d.skip(4 * n)
+			return
+		}
+		if n > 2 {
+			g.Positions = make([]int32, n)
+			for i := range g.Positions {
+				g.Positions[i] = d.int32()
+			}
+		}
+		g.Colors = make([]ColorRef, n)
+		for i := range g.Colors {
+			g.Colors[i] = ColorRef(d.uint32())
+		}
+	}
+
+	if f.Has(FillImage) {
+		img := &f.Image
+		img.Type = ImageFillType(d.uint8())
+		img.Brightness = d.int8()
+		img.Contrast = d.int8()
+		img.Effect = ImageEffect(d.uint8())
+		img.BinItem = d.uint16()
+	}
+
+	// The only additional property defined so far is the blur center of
+	// the gradient.
+	extra := d.bytes(int(d.uint32()))
+	if f.Has(FillGradient) && len(extra) > 0 {
+		f.Gradient.BlurCenter = extra[0]
+	}
+
+	if n := bits.OnesCount32(uint32(f.Type)); d.remaining() >= n {
+		f.Undefined = d.bytes(n)
+	}
+}
+
+func (bf *BorderFill) deserialize(data []byte) error {
+	d := newDataReader(data)
+	bf.Property = d.uint16()
+
+	for i := range bf.Borders {
+		bf.Borders[i] = readBorder(d)
+	}
+	bf.Diagonal = readBorder(d)
+
+	bf.Fill.deserialize(d)
+	return d.err
+}
+
+// readBorder reads the type, thickness and color of a line.
+func readBorder(d *dataReader) Border {
+	return Border{
+		Type:      LineType(d.uint8()),
+		Thickness: LineThickness(d.uint8()),
+		Color:     ColorRef(d.uint32()),
+	}
+}
+
+func decodeBorderFill(rec *Record, ver FileVersion) (interface{}, error) {
+	bf := new(BorderFill)
+	err := bf.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return bf, nil
+}
+
+func init() {
+	registerDecoder(TagBorderFill, decodeBorderFill)
+}
@@ -0,0 +1,121 @@
+package hwp50
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// TestBorderFills checks the border fills of the testdata file.
+//
+// TestBorderFills는 testdata 파일의 테두리/배경을 확인합니다.
+func TestBorderFills(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fills := hwp.DocInfo.BorderFill
+	if len(fills) != 2 {
+		t.Fatalf("expected 2 border fills, got %d", len(fills))
+	}
+
+	for _, bf := range fills {
+		for side, b := range bf.Borders {
+			if b != (Border{}) {
+				t.Errorf("side %d: unexpected border %+v", side, b)
+			}
+		}
+		if bf.Diagonal.Type != LineSolid {
+			t.Errorf("unexpected diagonal %+v", bf.Diagonal)
+		}
+	}
+
+	if fills[0].Fill.Type != FillNone {
+		t.Errorf("unexpected fill %+v", fills[0].Fill)
+	}
+
+	fill := fills[1].Fill
+	if !fill.Has(FillSolid) || fill.Has(FillGradient) {
+		t.Fatalf("unexpected fill type %d", fill.Type)
+	}
+	want := SolidFill{Background: ColorNone, PatternColor: 0xff000000,
+		Hatch: HatchNone}
+	if fill.Solid != want {
+		t.Errorf("expected %+v, got %+v", want, fill.Solid)
+	}
+	if len(fill.Undefined) != 1 {
+		t.Errorf("expected 1 undefined byte, got %v", fill.Undefined)
+	}
+}
+
+// littleEndian packs fixed size values the way records store them.
+func littleEndian(values ...interface{}) []byte {
+	var buf bytes.Buffer
+	for _, v := range values {
+		err := binary.Write(&buf, binary.LittleEndian, v)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+// TestGradientImageFill decodes a border fill with a gradient and an image.
+//
+// TestGradientImageFill은 그러데이션과 그림이 있는 테두리/배경을 읽습니다.
+func TestGradientImageFill(t *testing.T) {
+	data := littleEndian(uint16(1<<1 | 3<<2))
+	for i := 0; i < 5; i++ {
+		data = append(data, littleEndian(LineDot, uint8(3), uint32(0x0000ff))...)
+	}
+	data = append(data, littleEndian(
+		FillGradient|FillImage,
+		GradientRadial, int32(45), int32(50), int32(60), int32(10),
+		int32(3), []int32{0, 50, 100}, []uint32{0x000000, 0x808080, 0xffffff},
+		ImageStretch, int8(-2), int8(5), ImageGrayscale, uint16(2),
+		uint32(1), uint8(70),
+	)...)
+
+	var bf BorderFill
+	err := bf.deserialize(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bf.HasShadows() || bf.Has3DEffect() || bf.GetSlashShape() != 3 {
+		t.Errorf("unexpected property %#x", bf.Property)
+	}
+	left := bf.Borders[BorderLeft]
+	if left.Type != LineDot || left.Color.String() != "#ff0000" {
+		t.Errorf("unexpected border %+v", left)
+	}
+	if mm, err := left.Thickness.Millimeters(); err != nil || mm != 0.2 {
+		t.Errorf("unexpected thickness %v %v", mm, err)
+	}
+
+	g := bf.Fill.Gradient
+	if g.Type != GradientRadial || g.Angle != 45 || g.CenterX != 50 ||
+		g.CenterY != 60 || g.Blur != 10 || g.BlurCenter != 70 {
+		t.Errorf("unexpected gradient %+v", g)
+	}
+	if len(g.Positions) != 3 || g.Positions[2] != 100 ||
+		len(g.Colors) != 3 || g.Colors[1].String() != "#808080" {
+		t.Errorf("unexpected gradient stops %v %v", g.Positions, g.Colors)
+	}
+
+	want := ImageFill{Type: ImageStretch, Brightness: -2, Contrast: 5,
+		Effect: ImageGrayscale, BinItem: 2}
+	if bf.Fill.Image != want {
+		t.Errorf("expected %+v, got %+v", want, bf.Fill.Image)
+	}
+	if bf.Fill.Undefined != nil {
+		t.Errorf("unexpected undefined bytes %v", bf.Fill.Undefined)
+	}
+
+	err = bf.deserialize(data[:60])
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package hwp50
+
+// CharShape is the look of a run of characters: its fonts, size, colors
+// and decorations. Paragraphs point at char shapes by their index.
+//
+// CharShape는 글자들의 모양(글꼴, 크기, 색, 꾸밈)입니다. 문단은 순번으로
+// 글자 모양을 가리킵니다.
+type CharShape struct {
+	// FaceID is the id of the font within its language group. This and the
+	// next four arrays are indexed by FontLanguage.
+	FaceID [fontLanguages]uint16
+
+	// Ratio is the width of the characters in percent.
+	Ratio [fontLanguages]uint8
+
+	// Spacing is the space between characters in percent.
+	Spacing [fontLanguages]int8
+
+	// RelSize is the size relative to BaseSize in percent.
+	RelSize [fontLanguages]uint8
+
+	// Offset is how far the characters are raised in percent.
+	Offset [fontLanguages]int8
+
+	// BaseSize is the size of the characters in 1/100 pt.
+	BaseSize int32
+
+	// Implements "Chart 35" in the hwp50 specs
+	Property uint32
+
+	// ShadowOffsetX and ShadowOffsetY are the distance of the shadow in
+	// percent.
+	ShadowOffsetX, ShadowOffsetY int8
+
+	TextColor      ColorRef
+	UnderlineColor ColorRef
+	ShadeColor     ColorRef
+	ShadowColor    ColorRef
+
+	// Only relevant for hwp 5.0.2.1 and up
+	BorderFillID uint16
+
+	// Only relevant for hwp 5.0.3.0 and up
+	StrikeoutColor ColorRef
+}
+
+// LineShape is a line type in the order of the spec's table, which
+// starts at solid. Bit fields of char shapes store lines this way.
+//
+// LineShape는 실선부터 시작하는 스펙 표 순서의 선 종류입니다.
+type LineShape uint8
+
+// LineType returns s as a LineType.
+func (s LineShape) LineType() LineType {
+	return LineType(s) + 1
+}
+
+func (s LineShape) String() string {
+	return s.LineType().String()
+}
+
+// UnderlineType is where the underline is drawn.
+//
+// UnderlineType은 밑줄의 위치입니다.
+type UnderlineType uint8
+
+const (
+	UnderlineNone   UnderlineType = 0
+	UnderlineBottom UnderlineType = 1
+	UnderlineTop    UnderlineType = 3
+)
+
+// OutlineType is the line drawn around the characters.
+//
+// OutlineType은 외곽선의 종류입니다.
+type OutlineType uint8
+
+const (
+	OutlineNone OutlineType = iota
+	OutlineSolid
+	OutlineDot
+	OutlineThick
+	OutlineDash
+	OutlineDashDot
+	OutlineDashDotDot
+)
+
+// ShadowType is the kind of shadow of the characters.
+//
+// ShadowType은 그림자의 종류입니다.
+type ShadowType uint8
+
+const (
+	ShadowNone ShadowType = iota
+	ShadowDiscrete
+	ShadowContinuous
+)
+
+// EmphasisMark is the mark drawn over the characters.
+//
+// EmphasisMark는 글자 위에 찍는 강조점입니다.
+type EmphasisMark uint8
+
+const (
+	EmphasisNone EmphasisMark = iota
+	EmphasisDot
+	EmphasisCircle
+	EmphasisCaron
+	EmphasisTilde
+	EmphasisMiddleDot
+	EmphasisColon
+)
+
+// bits32 returns the n bits of v starting at bit shift.
+func bits32(v uint32, shift, n uint) uint32 {
+	return (v >> shift) & (1<<n - 1)
+}
+
+func (cs *CharShape) IsItalic() bool {
+	return bits32(cs.Property, 0, 1) != 0
+}
+
+func (cs *CharShape) IsBold() bool {
+	return bits32(cs.Property, 1, 1) != 0
+}
+
+// Underline returns bits 2~3
+func (cs *CharShape) Underline() UnderlineType {
+	return UnderlineType(bits32(cs.Property, 2, 2))
+}
+
+// UnderlineShape returns bits 4~7
+func (cs *CharShape) UnderlineShape() LineShape {
+	return LineShape(bits32(cs.Property, 4, 4))
+}
+
+// Outline returns bits 8~10
+func (cs *CharShape) Outline() OutlineType {
+	return OutlineType(bits32(cs.Property, 8, 3))
+}
+
+// Shadow returns bits 11~12
+func (cs *CharShape) Shadow() ShadowType {
+	return ShadowType(bits32(cs.Property, 11, 2))
+}
+
+func (cs *CharShape) IsEmbossed() bool {
+	return bits32(cs.Property, 13, 1) != 0
+}
+
+func (cs *CharShape) IsEngraved() bool {
+	return bits32(cs.Property, 14, 1) != 0
+}
+
+func (cs *CharShape) IsSuperscript() bool {
+	return bits32(cs.Property, 15, 1) != 0
+}
+
+func (cs *CharShape) IsSubscript() bool {
+	return bits32(cs.Property, 16, 1) != 0
+}
+
+// HasStrikeout reports if bits 18~20 are set.
+func (cs *CharShape) HasStrikeout() bool {
+	return bits32(cs.Property, 18, 3) != 0
+}
+
+// Emphasis returns bits 21~24
+func (cs *CharShape) Emphasis() EmphasisMark {
+	return EmphasisMark(bits32(cs.Property, 21, 4))
+}
+
+// UsesFontSpacing reports if the spacing defined by the font is used.
+func (cs *CharShape) UsesFontSpacing() bool {
+	return bits32(cs.Property, 25, 1) != 0
+}
+
+// StrikeoutShape returns bits 26~29
+func (cs *CharShape) StrikeoutShape() LineShape {
+	return LineShape(bits32(cs.Property, 26, 4))
+}
+
+func (cs *CharShape) HasKerning() bool {
+	return bits32(cs.Property, 30, 1) != 0
+}
+
+// Points returns BaseSize in points.
+//
+// Points는 BaseSize를 포인트 단위로 반환합니다.
+func (cs *CharShape) Points() float64 {
+	return float64(cs.BaseSize) / 100
+}
+
+func (cs *CharShape) deserialize(data []byte, ver FileVersion) error {
+	d := newDataReader(data)
+	for i := range cs.FaceID {
+		cs.FaceID[i] = d.uint16()
+	}
+	for i := range cs.Ratio {
+		cs.Ratio[i] = d.uint8()
+	}
+	for i := range cs.Spacing {
+		cs.Spacing[i] = d.int8()
+	}
+	for i := range cs.RelSize {
+		cs.RelSize[i] = d.uint8()
+	}
+	for i := range cs.Offset {
+		cs.Offset[i] = d.int8()
+	}
+
+	cs.BaseSize = d.int32()
+	cs.Property = d.uint32()
+	cs.ShadowOffsetX = d.int8()
+	cs.ShadowOffsetY = d.int8()
+	cs.TextColor = ColorRef(d.uint32())
+	cs.UnderlineColor = ColorRef(d.uint32())
+	cs.ShadeColor = ColorRef(d.uint32())
+	cs.ShadowColor = ColorRef(d.uint32())
+
+	if ver.AtLeast(5, 0, 2, 1) && d.remaining() >= 2 {
+		cs.BorderFillID = d.uint16()
+	}
+	if ver.AtLeast(5, 0, 3, 0) && d.remaining() >= 4 {
+		cs.StrikeoutColor = ColorRef(d.uint32())
+	}
+
+	return d.err
+}
+
+func decodeCharShape(rec *Record, ver FileVersion) (interface{}, error) {
+	cs := new(CharShape)
+	err := cs.deserialize(rec.Data, ver)
+	if err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+func init() {
+	registerDecoder(TagCharShape, decodeCharShape)
+}
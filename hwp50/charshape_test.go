@@ -0,0 +1,120 @@
+package hwp50
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestCharShapes checks the char shapes of the testdata file.
+//
+// TestCharShapes는 testdata 파일의 글자 모양을 확인합니다.
+func TestCharShapes(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shapes := hwp.DocInfo.CharShape
+	if len(shapes) != 5 {
+		t.Fatalf("expected 5 char shapes, got %d", len(shapes))
+	}
+
+	cs := shapes[0]
+	for lang := FontHangul; lang <= FontUser; lang++ {
+		if cs.FaceID[lang] != 1 || cs.Ratio[lang] != 100 ||
+			cs.Spacing[lang] != 0 || cs.RelSize[lang] != 100 ||
+			cs.Offset[lang] != 0 {
+			t.Errorf("%v: unexpected metrics", lang)
+		}
+	}
+	if fn, ok := hwp.DocInfo.Font(FontHangul, cs.FaceID[FontHangul]); !ok ||
+		fn.FName != "함초롬바탕" {
+		t.Errorf("unexpected font %v", fn)
+	}
+	if cs.Points() != 10 || cs.Property != 0 {
+		t.Errorf("unexpected size %v or property %#x", cs.Points(), cs.Property)
+	}
+	if cs.ShadowOffsetX != 10 || cs.ShadowOffsetY != 10 {
+		t.Errorf("unexpected shadow offset %d %d",
+			cs.ShadowOffsetX, cs.ShadowOffsetY)
+	}
+	if cs.TextColor.String() != "#000000" || cs.ShadeColor != ColorNone ||
+		cs.ShadowColor.String() != "#b2b2b2" {
+		t.Errorf("unexpected colors %v %v %v",
+			cs.TextColor, cs.ShadeColor, cs.ShadowColor)
+	}
+	if cs.BorderFillID != 2 || cs.StrikeoutColor != 0 {
+		t.Errorf("unexpected border fill %d or strikeout color %v",
+			cs.BorderFillID, cs.StrikeoutColor)
+	}
+
+	if shapes[4].Spacing[FontLatin] != -5 || shapes[4].Points() != 9 {
+		t.Errorf("unexpected spacing %d or size %v",
+			shapes[4].Spacing[FontLatin], shapes[4].Points())
+	}
+}
+
+// TestCharShapeProperty checks the bit fields of CharShape.Property.
+//
+// TestCharShapeProperty는 CharShape.Property의 비트들을 확인합니다.
+func TestCharShapeProperty(t *testing.T) {
+	cs := CharShape{Property: 1<<0 | 3<<2 | 2<<4 | 4<<8 | 2<<11 | 1<<14 |
+		1<<16 | 1<<18 | 5<<21 | 7<<26 | 1<<30}
+
+	if !cs.IsItalic() || cs.IsBold() {
+		t.Error("expected italic only")
+	}
+	if cs.Underline() != UnderlineTop || cs.UnderlineShape() != 2 ||
+		cs.UnderlineShape().LineType() != LineDot {
+		t.Errorf("unexpected underline %v %v", cs.Underline(), cs.UnderlineShape())
+	}
+	if cs.Outline() != OutlineDash || cs.Shadow() != ShadowContinuous {
+		t.Errorf("unexpected outline %v or shadow %v", cs.Outline(), cs.Shadow())
+	}
+	if cs.IsEmbossed() || !cs.IsEngraved() {
+		t.Error("expected engraved only")
+	}
+	if cs.IsSuperscript() || !cs.IsSubscript() {
+		t.Error("expected subscript only")
+	}
+	if !cs.HasStrikeout() || cs.StrikeoutShape().LineType() != LineDouble {
+		t.Errorf("unexpected strikeout %v", cs.StrikeoutShape())
+	}
+	if cs.Emphasis() != EmphasisMiddleDot || cs.UsesFontSpacing() ||
+		!cs.HasKerning() {
+		t.Errorf("unexpected emphasis %v or spacing", cs.Emphasis())
+	}
+}
+
+// TestCharShapeVersions checks that the fields added in later versions are
+// left out of older files.
+//
+// TestCharShapeVersions는 나중 버전에 추가된 필드가 예전 파일에서는
+// 빠지는지 확인합니다.
+func TestCharShapeVersions(t *testing.T) {
+	data := make([]byte, 74)
+	data[68] = 7
+	data[70] = 0xff
+
+	var cs CharShape
+	err := cs.deserialize(data, FileVersion{5, 0, 2, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs.BorderFillID != 0 || cs.StrikeoutColor != 0 {
+		t.Errorf("unexpected fields %d %v", cs.BorderFillID, cs.StrikeoutColor)
+	}
+
+	err = cs.deserialize(data, FileVersion{5, 0, 3, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs.BorderFillID != 7 || cs.StrikeoutColor.String() != "#ff0000" {
+		t.Errorf("unexpected fields %d %v", cs.BorderFillID, cs.StrikeoutColor)
+	}
+
+	err = cs.deserialize(data[:60], FileVersion{5, 0, 3, 0})
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package hwp50
+
+// TargetProgram is the program a document keeps its layout compatible
+// with.
+//
+// TargetProgram은 문서가 조판을 맞추는 대상 프로그램입니다.
+type TargetProgram uint32
+
+const (
+	// TargetHWP is the current version of Hangul.
+	TargetHWP TargetProgram = iota
+
+	TargetHWP2007
+
+	TargetMSWord
+)
+
+func (t TargetProgram) String() string {
+	switch t {
+	case TargetHWP:
+		return "HWP"
+	case TargetHWP2007:
+		return "HWP 2007"
+	case TargetMSWord:
+		return "MS Word"
+	}
+	return "unknown"
+}
+
+// CompatibleDocument tells which program the layout of the document
+// follows. Spacing and line breaking differ between them.
+//
+// CompatibleDocument는 문서의 조판이 어느 프로그램을 따르는지를
+// 나타냅니다. 프로그램마다 간격과 줄 나눔이 다릅니다.
+type CompatibleDocument struct {
+	Target TargetProgram
+}
+
+func (cd *CompatibleDocument) deserialize(data []byte) error {
+	d := newDataReader(data)
+	cd.Target = TargetProgram(d.uint32())
+	return d.err
+}
+
+func decodeCompatibleDocument(rec *Record, ver FileVersion) (interface{}, error) {
+	cd := new(CompatibleDocument)
+	err := cd.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return cd, nil
+}
+
+// LayoutFlags is a bit mask of layout compatibility options of one level
+// of formatting. The spec doesn't say which option each bit is, so they're
+// left unnamed.
+//
+// LayoutFlags는 서식 단위 하나의 조판 호환 옵션 비트 마스크입니다. 스펙에
+// 비트별 옵션이 나와 있지 않아서 이름을 붙이지 않았습니다.
+type LayoutFlags uint32
+
+// Has reports if bit n is set.
+//
+// Has는 n번 비트가 켜져 있는지를 뜻합니다.
+func (f LayoutFlags) Has(n uint) bool {
+	return f&(1<<n) != 0
+}
+
+// LayoutCompatibility holds the layout compatibility options, one mask per
+// level of formatting.
+//
+// LayoutCompatibility는 서식 단위별 조판 호환 옵션입니다.
+type LayoutCompatibility struct {
+	Char      LayoutFlags
+	Paragraph LayoutFlags
+	Section   LayoutFlags
+	Object    LayoutFlags
+	Field     LayoutFlags
+}
+
+func (lc *LayoutCompatibility) deserialize(data []byte) error {
+	d := newDataReader(data)
+	for _, f := range []*LayoutFlags{
+		&lc.Char, &lc.Paragraph, &lc.Section, &lc.Object, &lc.Field,
+	} {
+		*f = LayoutFlags(d.uint32())
+	}
+	return d.err
+}
+
+func decodeLayoutCompatibility(rec *Record, ver FileVersion) (interface{}, error) {
+	lc := new(LayoutCompatibility)
+	err := lc.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return lc, nil
+}
+
+// ForbiddenChar holds the chars that may not start or end a line.
+//
+// The spec only names the record. It's read as two strings, each a DWORD
+// count of WCHARs followed by the WCHARs, and bytes past them are kept in
+// Undefined. That layout hasn't been checked against a file with non-empty
+// lists, so when the data doesn't fit it both lists are left empty, the
+// whole record is kept in Undefined and Err tells why, instead of failing
+// DocInfo.
+//
+// ForbiddenChar는 줄 처음과 끝에 올 수 없는 글자들입니다. 스펙에는
+// 레코드의 이름만 있어서, WCHAR 개수(DWORD)와 WCHAR들로 된 문자열 두
+// 개로 읽고 나머지 바이트는 Undefined에 둡니다. 이 형식에 맞지 않으면
+// 레코드 전체를 Undefined에 두고 Err에 이유를 담습니다.
+type ForbiddenChar struct {
+	// LineStart are the chars that may not start a line, like ")" or ".".
+	LineStart string
+
+	// LineEnd are the chars that may not end a line, like "(".
+	LineEnd string
+
+	Undefined []byte
+
+	// Err is why the record didn't fit the layout, or nil if it did.
+	Err error
+}
+
+func (fc *ForbiddenChar) deserialize(data []byte) error {
+	d := newDataReader(data)
+	fc.LineStart = wcharsToString(d.wchars(int(d.uint32())))
+	fc.LineEnd = wcharsToString(d.wchars(int(d.uint32())))
+	fc.Undefined = d.bytes(d.remaining())
+	return d.err
+}
+
+func decodeForbiddenChar(rec *Record, ver FileVersion) (interface{}, error) {
+	fc := new(ForbiddenChar)
+	err := fc.deserialize(rec.Data)
+	if err != nil {
+		fc = &ForbiddenChar{
+			Undefined: append([]byte(nil), rec.Data...),
+			Err:       err,
+		}
+	}
+	return fc, nil
+}
+
+func init() {
+	registerDecoder(TagCompatibleDocument, decodeCompatibleDocument)
+	registerDecoder(TagLayoutCompatibility, decodeLayoutCompatibility)
+	registerDecoder(TagForbiddenChar, decodeForbiddenChar)
+}
@@ -0,0 +1,88 @@
+package hwp50
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestCompatibility checks the compatibility records of the testdata file.
+//
+// TestCompatibility는 testdata 파일의 호환 레코드를 확인합니다.
+func TestCompatibility(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	di := hwp.DocInfo
+	if di.CompatibleDocument.Target != TargetHWP ||
+		di.CompatibleDocument.Target.String() != "HWP" {
+		t.Errorf("unexpected target %v", di.CompatibleDocument.Target)
+	}
+	if di.LayoutCompatibility != (LayoutCompatibility{}) {
+		t.Errorf("unexpected layout compatibility %+v", di.LayoutCompatibility)
+	}
+	if di.ForbiddenChar.LineStart != "" || di.ForbiddenChar.LineEnd != "" ||
+		len(di.ForbiddenChar.Undefined) != 8 || di.ForbiddenChar.Err != nil {
+		t.Errorf("unexpected forbidden chars %+v", di.ForbiddenChar)
+	}
+}
+
+// TestDecodeCompatibility decodes a Word compatible document and forbidden
+// chars.
+//
+// TestDecodeCompatibility는 워드 호환 문서와 금칙 문자를 읽습니다.
+func TestDecodeCompatibility(t *testing.T) {
+	var cd CompatibleDocument
+	err := cd.deserialize(littleEndian(uint32(2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cd.Target != TargetMSWord || cd.Target.String() != "MS Word" {
+		t.Errorf("unexpected target %v", cd.Target)
+	}
+
+	var lc LayoutCompatibility
+	err = lc.deserialize(littleEndian([]uint32{1, 2, 4, 8, 0x8000}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !lc.Char.Has(0) || !lc.Paragraph.Has(1) || !lc.Section.Has(2) ||
+		!lc.Object.Has(3) || !lc.Field.Has(15) || lc.Field.Has(0) {
+		t.Errorf("unexpected layout compatibility %+v", lc)
+	}
+	err = lc.deserialize(make([]byte, 16))
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+
+	var fc ForbiddenChar
+	err = fc.deserialize(littleEndian(uint32(3), []uint16{')', '.', '」'},
+		uint32(2), []uint16{'(', '「'}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fc.LineStart != ").」" || fc.LineEnd != "(「" || len(fc.Undefined) != 0 {
+		t.Errorf("unexpected forbidden chars %+v", fc)
+	}
+	err = fc.deserialize(littleEndian(uint32(3), []uint16{')'}))
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+
+	// A record that doesn't fit the layout is kept as it is.
+	// 형식에 맞지 않는 레코드는 그대로 둡니다.
+	data := littleEndian(uint32(0xFFFF), []uint16{')', '.'})
+	v, err := DecodeRecord(&Record{TagID: TagForbiddenChar, Data: data},
+		FileVersion{5, 0, 4, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := v.(*ForbiddenChar)
+	if got.LineStart != "" || got.LineEnd != "" ||
+		!bytes.Equal(got.Undefined, data) ||
+		!errors.Is(got.Err, ErrTruncatedRecord) {
+		t.Errorf("unexpected forbidden chars %+v", got)
+	}
+}
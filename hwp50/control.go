@@ -0,0 +1,161 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// Ids of the other controls that have a decoder. Fields have ids starting
+// with '%', see the CtrlIDField constants.
+//
+// 디코더가 있는 나머지 컨트롤들의 id 입니다. 필드는 '%'로 시작하는 id를
+// 가지며 CtrlIDField 상수들을 보세요.
+const (
+	CtrlIDEquation           CtrlID = 'e'<<24 | 'q'<<16 | 'e'<<8 | 'd'
+	CtrlIDColumnDef          CtrlID = 'c'<<24 | 'o'<<16 | 'l'<<8 | 'd'
+	CtrlIDAutoNumber         CtrlID = 'a'<<24 | 't'<<16 | 'n'<<8 | 'o'
+	CtrlIDNewNumber          CtrlID = 'n'<<24 | 'w'<<16 | 'n'<<8 | 'o'
+	CtrlIDPageHide           CtrlID = 'p'<<24 | 'g'<<16 | 'h'<<8 | 'd'
+	CtrlIDPageAdjust         CtrlID = 'p'<<24 | 'g'<<16 | 'c'<<8 | 't'
+	CtrlIDPageNumberPosition CtrlID = 'p'<<24 | 'g'<<16 | 'n'<<8 | 'p'
+	CtrlIDIndexMark          CtrlID = 'i'<<24 | 'd'<<16 | 'x'<<8 | 'm'
+	CtrlIDBookmark           CtrlID = 'b'<<24 | 'o'<<16 | 'k'<<8 | 'm'
+	CtrlIDCharOverlap        CtrlID = 't'<<24 | 'c'<<16 | 'p'<<8 | 's'
+	CtrlIDDutmal             CtrlID = 't'<<24 | 'd'<<16 | 'u'<<8 | 't'
+	CtrlIDHiddenComment      CtrlID = 't'<<24 | 'c'<<16 | 'm'<<8 | 't'
+)
+
+// Ids of the fields.
+//
+// 필드들의 id 입니다.
+const (
+	CtrlIDFieldUnknown   CtrlID = '%'<<24 | 'u'<<16 | 'n'<<8 | 'k'
+	CtrlIDFieldDate      CtrlID = '%'<<24 | 'd'<<16 | 't'<<8 | 'e'
+	CtrlIDFieldDocDate   CtrlID = '%'<<24 | 'd'<<16 | 'd'<<8 | 't'
+	CtrlIDFieldPath      CtrlID = '%'<<24 | 'p'<<16 | 'a'<<8 | 't'
+	CtrlIDFieldBookmark  CtrlID = '%'<<24 | 'b'<<16 | 'm'<<8 | 'k'
+	CtrlIDFieldMailMerge CtrlID = '%'<<24 | 'm'<<16 | 'm'<<8 | 'g'
+	CtrlIDFieldCrossRef  CtrlID = '%'<<24 | 'x'<<16 | 'r'<<8 | 'f'
+	CtrlIDFieldFormula   CtrlID = '%'<<24 | 'f'<<16 | 'm'<<8 | 'u'
+	CtrlIDFieldClickHere CtrlID = '%'<<24 | 'c'<<16 | 'l'<<8 | 'k'
+	CtrlIDFieldSummary   CtrlID = '%'<<24 | 's'<<16 | 'm'<<8 | 'r'
+	CtrlIDFieldUserInfo  CtrlID = '%'<<24 | 'u'<<16 | 's'<<8 | 'r'
+	CtrlIDFieldHyperlink CtrlID = '%'<<24 | 'h'<<16 | 'l'<<8 | 'k'
+	CtrlIDFieldMemo      CtrlID = '%'<<24 | '%'<<16 | 'm'<<8 | 'e'
+	CtrlIDFieldTOC       CtrlID = '%'<<24 | 't'<<16 | 'o'<<8 | 'c'
+)
+
+// IsField reports if id is the id of a field, which all start with '%'.
+//
+// IsField는 id가 '%'로 시작하는 필드의 id인지를 뜻합니다.
+func (id CtrlID) IsField() bool {
+	return id>>24 == '%'
+}
+
+// Control is the decoded CTRL_HEADER of an extended control, such as a
+// *Table or a *SectionDef. Controls without a decoder are an
+// *UnknownControl.
+//
+// Control은 *Table, *SectionDef 같이 decode 된 확장 컨트롤의
+// CTRL_HEADER입니다. decoder가 없는 컨트롤은 *UnknownControl 입니다.
+type Control interface {
+	CtrlID() CtrlID
+}
+
+// ControlDecoder decodes the CTRL_HEADER node of a control. The data of the
+// node starts with the ctrl id and its children hold the rest of the
+// control, such as its CTRL_DATA.
+//
+// ControlDecoder는 컨트롤의 CTRL_HEADER 노드를 decode 합니다. 노드의
+// 데이터는 ctrl id로 시작하고, 자식 노드들에 CTRL_DATA 같은 컨트롤의
+// 나머지가 있습니다.
+type ControlDecoder func(ctrl *RecordNode, ver FileVersion) (Control, error)
+
+var (
+	controlDecodersMtx sync.RWMutex
+	controlDecoders    = make(map[CtrlID]ControlDecoder)
+)
+
+// RegisterControlDecoder registers dec as the decoder of the controls with
+// the given id, replacing any previous one.
+//
+// RegisterControlDecoder는 dec를 주어진 id의 컨트롤 decoder로 등록합니다.
+// 이미 있던 decoder는 대체됩니다.
+func RegisterControlDecoder(id CtrlID, dec ControlDecoder) {
+	controlDecodersMtx.Lock()
+	defer controlDecodersMtx.Unlock()
+
+	controlDecoders[id] = dec
+}
+
+// DecodeControl decodes the CTRL_HEADER node ctrl with the decoder
+// registered for its ctrl id. Fields without a decoder of their own are
+// decoded as a *Field and other controls as an *UnknownControl.
+//
+// DecodeControl은 CTRL_HEADER 노드 ctrl을 ctrl id에 등록된 decoder로
+// decode 합니다. 따로 decoder가 없는 필드는 *Field로, 다른 컨트롤은
+// *UnknownControl로 decode 됩니다.
+func DecodeControl(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	if len(ctrl.Data) < 4 {
+		return nil, &RecordError{
+			Offset: ctrl.Offset,
+			TagID:  ctrl.TagID,
+			Err:    fmt.Errorf("%w: no ctrl id", ErrTruncatedRecord),
+		}
+	}
+	id := CtrlID(binary.LittleEndian.Uint32(ctrl.Data))
+
+	controlDecodersMtx.RLock()
+	dec, ok := controlDecoders[id]
+	controlDecodersMtx.RUnlock()
+
+	switch {
+	case ok:
+	case id.IsField():
+		dec = decodeField
+	default:
+		dec = decodeUnknownControl
+	}
+
+	c, err := dec(ctrl, ver)
+	if err != nil {
+		return nil, &RecordError{
+			Offset: ctrl.Offset,
+			TagID:  ctrl.TagID,
+			Err:    fmt.Errorf("%v: %w", id, err),
+		}
+	}
+	return c, nil
+}
+
+// ctrlDataReader returns a reader of the data of ctrl past its ctrl id.
+func ctrlDataReader(ctrl *RecordNode) *dataReader {
+	d := newDataReader(ctrl.Data)
+	d.skip(4)
+	return d
+}
+
+// UnknownControl is a control without a decoder, or one its decoder
+// couldn't read.
+//
+// UnknownControl은 decoder가 없거나 decoder가 읽지 못한 컨트롤입니다.
+type UnknownControl struct {
+	ID CtrlID
+
+	// Data is the data of the CTRL_HEADER past the ctrl id.
+	Data []byte
+
+	// Err is why the decoder failed, or nil when there's no decoder.
+	Err error
+}
+
+func (c *UnknownControl) CtrlID() CtrlID { return c.ID }
+
+func decodeUnknownControl(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &UnknownControl{
+		ID:   CtrlID(binary.LittleEndian.Uint32(ctrl.Data)),
+		Data: d.bytes(d.remaining()),
+	}, d.err
+}
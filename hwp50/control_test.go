@@ -0,0 +1,253 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// ctrlNode returns a CTRL_HEADER node of the control id with the given data
+// after the id and the given children.
+func ctrlNode(id CtrlID, data []byte, children ...*RecordNode) *RecordNode {
+	return &RecordNode{
+		Record: &Record{
+			TagID: TagCtrlHeader,
+			Data:  append(littleEndian(uint32(id)), data...),
+		},
+		Children: children,
+	}
+}
+
+// ctrlDataNode returns a CTRL_DATA node naming a bookmark or a field.
+func ctrlDataNode(name string) *RecordNode {
+	return &RecordNode{Record: &Record{
+		TagID: TagCtrlData,
+		Data: littleEndian(uint16(0x21b), int16(1),
+			uint16(0x4000), ParameterBSTR, bstr(name)),
+	}}
+}
+
+// TestControls checks the controls of the testdata file.
+//
+// TestControls는 testdata 파일의 컨트롤을 확인합니다.
+func TestControls(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ctrls []Control
+	for _, run := range hwp.BodyText[0].Paragraphs[0].Runs {
+		if run.Kind == RunExtendedControl {
+			ctrls = append(ctrls, run.Control)
+		}
+	}
+	if len(ctrls) != 2 {
+		t.Fatalf("expected 2 controls, got %d", len(ctrls))
+	}
+
+	sd, ok := ctrls[0].(*SectionDef)
+	if !ok {
+		t.Fatalf("expected a *SectionDef, got %T", ctrls[0])
+	}
+	want := SectionDef{ColumnGap: 1134, DefaultTabSpacing: 8000,
+		OutlineNumberingID: 1}
+	if *sd != want {
+		t.Errorf("expected %+v, got %+v", want, *sd)
+	}
+
+	cd, ok := ctrls[1].(*ColumnDef)
+	if !ok {
+		t.Fatalf("expected a *ColumnDef, got %T", ctrls[1])
+	}
+	if cd.Kind() != ColumnNormal || cd.Count() != 1 || !cd.SameWidth() ||
+		len(cd.Widths) != 0 {
+		t.Errorf("unexpected column def %+v", *cd)
+	}
+}
+
+// TestDecodeControl decodes a control of every kind.
+//
+// TestDecodeControl은 모든 종류의 컨트롤을 읽습니다.
+func TestDecodeControl(t *testing.T) {
+	object := littleEndian(uint32(1), int32(-10), int32(20), uint32(300),
+		uint32(400), int32(5), []int16{1, 2, 3, 4}, uint32(99), int32(0))
+	common := ObjectCommon{
+		Property: 1, VerticalOffset: -10, HorizontalOffset: 20, Width: 300,
+		Height: 400, ZOrder: 5, Margins: [4]int16{1, 2, 3, 4},
+		InstanceID: 99,
+	}
+	described := common
+	described.Description = "표"
+
+	tests := []struct {
+		ctrl *RecordNode
+		want Control
+	}{
+		{
+			ctrlNode(CtrlIDTable, append(object, littleEndian(bstr("표"))...)),
+			&Table{described},
+		},
+		{ctrlNode(CtrlIDShapeObject, object), &ShapeObject{common}},
+		{ctrlNode(CtrlIDEquation, object), &Equation{common}},
+		{
+			ctrlNode(CtrlIDColumnDef, littleEndian(uint16(2<<2|1), int16(500),
+				[]uint16{1000, 2000}, uint16(0), LineDot, uint8(1),
+				uint32(0xff))),
+			&ColumnDef{Property: 2<<2 | 1, Gap: 500, Widths: []uint16{1000, 2000},
+				SeparatorType: LineDot, SeparatorThickness: 1,
+				SeparatorColor: 0xff},
+		},
+		{
+			ctrlNode(CtrlIDFooter, littleEndian(uint32(PagesOdd))),
+			&HeaderFooter{ID: CtrlIDFooter, Property: uint32(PagesOdd)},
+		},
+		{
+			ctrlNode(CtrlIDEndnote, littleEndian(uint32(3), uint16(')'))),
+			&Note{ID: CtrlIDEndnote, Number: 3, Undefined: []byte{')', 0}},
+		},
+		{
+			ctrlNode(CtrlIDAutoNumber, littleEndian(uint32(1<<12|2<<4)|
+				uint32(NumberFootnote), uint16(7), uint16(0), uint16('['),
+				uint16(']'))),
+			&AutoNumber{Property: 1<<12 | 2<<4 | uint32(NumberFootnote),
+				Number: 7, Prefix: '[', Suffix: ']'},
+		},
+		{
+			ctrlNode(CtrlIDNewNumber, littleEndian(uint32(NumberTable), uint16(4))),
+			&NewNumber{Property: uint32(NumberTable), Number: 4},
+		},
+		{
+			ctrlNode(CtrlIDPageHide, littleEndian(uint32(1<<5|1))),
+			&PageHide{Property: 1<<5 | 1},
+		},
+		{
+			ctrlNode(CtrlIDPageAdjust, littleEndian(uint32(PagesEven))),
+			&PageAdjust{Property: uint32(PagesEven)},
+		},
+		{
+			ctrlNode(CtrlIDPageNumberPosition, littleEndian(uint32(5<<8),
+				uint16(0), uint16(0), uint16(0), uint16('-'))),
+			&PageNumberPosition{Property: 5 << 8, Dash: '-'},
+		},
+		{
+			ctrlNode(CtrlIDIndexMark, littleEndian(bstr("hwp"), bstr(""),
+				uint16(0))),
+			&IndexMark{Keyword1: "hwp"},
+		},
+		{
+			ctrlNode(CtrlIDBookmark, nil, ctrlDataNode("intro")),
+			&Bookmark{Name: "intro"},
+		},
+		{ctrlNode(CtrlIDBookmark, nil), &Bookmark{}},
+		{
+			ctrlNode(CtrlIDCharOverlap, littleEndian(bstr("12"), uint8(1),
+				int8(80), int8(0), uint8(2), []uint32{3, 4})),
+			&CharOverlap{Text: "12", BorderType: 1, InnerSize: 80,
+				CharShapeIDs: []uint32{3, 4}},
+		},
+		{
+			ctrlNode(CtrlIDDutmal, littleEndian(bstr("漢字"), bstr("한자"),
+				[]uint32{0, 50, 0, 1, uint32(AlignCenter)})),
+			&Dutmal{Main: "漢字", Sub: "한자", SizeRatio: 50, StyleID: 1,
+				Alignment: AlignCenter},
+		},
+		{ctrlNode(CtrlIDHiddenComment, nil), &HiddenComment{}},
+		{
+			ctrlNode(CtrlIDFieldHyperlink, littleEndian(uint32(1), uint8(0),
+				bstr("http://a;1;0;0;"), uint32(7)), ctrlDataNode("link")),
+			&Field{ID: CtrlIDFieldHyperlink, Property: 1,
+				Command: "http://a;1;0;0;", InstanceID: 7, Name: "link"},
+		},
+		{
+			ctrlNode(CtrlID('%'<<24|'n'<<16|'e'<<8|'w'), littleEndian(uint32(0),
+				uint8(0), bstr(""), uint32(1))),
+			&Field{ID: '%'<<24 | 'n'<<16 | 'e'<<8 | 'w', InstanceID: 1},
+		},
+		{
+			ctrlNode(CtrlID('x'<<24|'y'<<16|'z'<<8|' '), []byte{1, 2}),
+			&UnknownControl{ID: 'x'<<24 | 'y'<<16 | 'z'<<8 | ' ',
+				Data: []byte{1, 2}},
+		},
+	}
+	for _, test := range tests {
+		c, err := DecodeControl(test.ctrl, FileVersion{5, 0, 4, 0})
+		if err != nil {
+			t.Errorf("%v: %v", test.want.CtrlID(), err)
+			continue
+		}
+		if !reflect.DeepEqual(c, test.want) {
+			t.Errorf("%v: expected %+v, got %+v", test.want.CtrlID(),
+				test.want, c)
+		}
+		if c.CtrlID() != CtrlID(binary.LittleEndian.Uint32(test.ctrl.Data)) {
+			t.Errorf("%v: unexpected id %v", test.want.CtrlID(), c.CtrlID())
+		}
+	}
+}
+
+// TestDecodeControlErrors checks that cut off controls are reported.
+//
+// TestDecodeControlErrors는 잘린 컨트롤을 오류로 알리는지 확인합니다.
+func TestDecodeControlErrors(t *testing.T) {
+	for _, ctrl := range []*RecordNode{
+		{Record: &Record{TagID: TagCtrlHeader, Data: []byte("ces")}},
+		ctrlNode(CtrlIDTable, make([]byte, 36)),
+		ctrlNode(CtrlIDSectionDef, make([]byte, 20)),
+		ctrlNode(CtrlIDColumnDef, littleEndian(uint16(2<<2), int16(0),
+			uint16(1000))),
+		ctrlNode(CtrlIDFieldClickHere, littleEndian(uint32(0), uint8(0),
+			uint16(5))),
+	} {
+		_, err := DecodeControl(ctrl, FileVersion{5, 0, 4, 0})
+		if !errors.Is(err, ErrTruncatedRecord) {
+			t.Errorf("%q: expected ErrTruncatedRecord, got %v", ctrl.Data, err)
+		}
+	}
+}
+
+// TestLinkBrokenControls checks that controls which can't be decoded don't
+// stop their paragraph from being read.
+//
+// TestLinkBrokenControls는 decode 할 수 없는 컨트롤이 있어도 문단을 읽는지
+// 확인합니다.
+func TestLinkBrokenControls(t *testing.T) {
+	var ctrls [][]*Record
+	for _, id := range []CtrlID{CtrlIDFieldHyperlink, CtrlIDTable} {
+		ctrls = append(ctrls, []*Record{{
+			TagID: TagCtrlHeader,
+			Level: 1,
+			Data:  littleEndian(uint32(id), uint16(1)),
+		}})
+	}
+
+	paras, err := paragraphs(BuildRecordTree(testParagraph(0, "link", ctrls...)),
+		FileVersion{5, 0, 4, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paras) != 1 || paras[0].Text() != "link" {
+		t.Fatalf("unexpected paragraphs %v", paras)
+	}
+
+	var ids []CtrlID
+	for _, run := range paras[0].Runs {
+		if run.Kind != RunExtendedControl {
+			continue
+		}
+		c, ok := run.Control.(*UnknownControl)
+		if !ok {
+			t.Fatalf("%v: expected an *UnknownControl, got %T", run.CtrlID,
+				run.Control)
+		}
+		if !errors.Is(c.Err, ErrTruncatedRecord) ||
+			!reflect.DeepEqual(c.Data, []byte{1, 0}) {
+			t.Errorf("%v: unexpected control %+v", run.CtrlID, c)
+		}
+		ids = append(ids, c.CtrlID())
+	}
+	if !reflect.DeepEqual(ids, []CtrlID{CtrlIDFieldHyperlink, CtrlIDTable}) {
+		t.Errorf("unexpected controls %v", ids)
+	}
+}
@@ -0,0 +1,665 @@
+package hwp50
+
+import "encoding/binary"
+
+// ObjectCommon holds the properties every object control (tables,
+// drawing objects and equations) starts its CTRL_HEADER with.
+//
+// ObjectCommon은 개체 컨트롤(표, 그리기 개체, 수식)의 CTRL_HEADER가 공통으로
+// 시작하는 속성입니다.
+type ObjectCommon struct {
+	// Property holds how the object is placed and wrapped.
+	Property uint32
+
+	// The offsets of the object from what it's placed against and its
+	// size, in HWPUNIT.
+	VerticalOffset   int32
+	HorizontalOffset int32
+	Width            uint32
+	Height           uint32
+
+	ZOrder int32
+
+	// Margins is the space around the object, indexed by BorderLeft,
+	// BorderRight, BorderTop and BorderBottom.
+	Margins [4]int16
+
+	InstanceID uint32
+
+	PreventPageBreak int32
+
+	// Description is the alternative text of the object.
+	Description string
+}
+
+// TreatAsChar reports if the object is placed in the text like a char.
+func (oc *ObjectCommon) TreatAsChar() bool {
+	return oc.Property&(1<<0) != 0
+}
+
+func (oc *ObjectCommon) read(d *dataReader) {
+	oc.Property = d.uint32()
+	oc.VerticalOffset = d.int32()
+	oc.HorizontalOffset = d.int32()
+	oc.Width = d.uint32()
+	oc.Height = d.uint32()
+	oc.ZOrder = d.int32()
+	for i := range oc.Margins {
+		oc.Margins[i] = d.int16()
+	}
+	oc.InstanceID = d.uint32()
+	oc.PreventPageBreak = d.int32()
+
+	// Files written before the description was added end here.
+	if d.remaining() >= 2 {
+		oc.Description = d.string()
+	}
+}
+
+// Table is a table control. Its cells are lists of paragraphs under the
+// CTRL_HEADER.
+//
+// Table은 표 컨트롤입니다. 셀은 CTRL_HEADER 아래의 문단 리스트입니다.
+type Table struct {
+	ObjectCommon
+}
+
+func (*Table) CtrlID() CtrlID { return CtrlIDTable }
+
+// ShapeObject is a drawing object such as a picture, a line or a text box.
+//
+// ShapeObject는 그림, 선, 글상자 같은 그리기 개체입니다.
+type ShapeObject struct {
+	ObjectCommon
+}
+
+func (*ShapeObject) CtrlID() CtrlID { return CtrlIDShapeObject }
+
+// Equation is an equation. The script is in its EQEDIT record.
+//
+// Equation은 수식입니다. 수식 스크립트는 EQEDIT 레코드에 있습니다.
+type Equation struct {
+	ObjectCommon
+}
+
+func (*Equation) CtrlID() CtrlID { return CtrlIDEquation }
+
+func decodeObject(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	var oc ObjectCommon
+	oc.read(d)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	switch CtrlID(binary.LittleEndian.Uint32(ctrl.Data)) {
+	case CtrlIDTable:
+		return &Table{oc}, nil
+	case CtrlIDShapeObject:
+		return &ShapeObject{oc}, nil
+	}
+	return &Equation{oc}, nil
+}
+
+// SectionDef starts every section and holds its settings. The page, the
+// note shapes and the page border of the section are under the CTRL_HEADER.
+//
+// SectionDef는 모든 구역의 처음에 오며 구역의 설정을 담습니다. 용지,
+// 각주/미주 모양, 쪽 테두리는 CTRL_HEADER 아래에 있습니다.
+type SectionDef struct {
+	Property uint32
+
+	// ColumnGap is the space between the columns of the same level, in
+	// HWPUNIT.
+	ColumnGap int16
+
+	// The grids of the section, 0 when off.
+	VerticalGrid   int16
+	HorizontalGrid int16
+
+	// DefaultTabSpacing is the width of the default tabs in HWPUNIT.
+	DefaultTabSpacing uint32
+
+	// OutlineNumberingID is the numbering of the outline headings,
+	// starting at 1.
+	OutlineNumberingID uint16
+
+	// The numbers the pages, pictures, tables and equations of the
+	// section start at. 0 continues from the previous section.
+	PageStart     uint16
+	PictureStart  uint16
+	TableStart    uint16
+	EquationStart uint16
+
+	// LangID is the language of the section as a Windows LCID.
+	// Only relevant for hwp 5.0.1.5 and up
+	LangID uint16
+}
+
+func (*SectionDef) CtrlID() CtrlID { return CtrlIDSectionDef }
+
+func decodeSectionDef(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	sd := &SectionDef{
+		Property:           d.uint32(),
+		ColumnGap:          d.int16(),
+		VerticalGrid:       d.int16(),
+		HorizontalGrid:     d.int16(),
+		DefaultTabSpacing:  d.uint32(),
+		OutlineNumberingID: d.uint16(),
+		PageStart:          d.uint16(),
+		PictureStart:       d.uint16(),
+		TableStart:         d.uint16(),
+		EquationStart:      d.uint16(),
+	}
+	if ver.AtLeast(5, 0, 1, 5) && d.remaining() >= 2 {
+		sd.LangID = d.uint16()
+	}
+	return sd, d.err
+}
+
+// ColumnKind is how text flows through the columns.
+//
+// ColumnKind는 단의 종류입니다.
+type ColumnKind uint8
+
+const (
+	ColumnNormal ColumnKind = iota
+	ColumnDistributed
+	ColumnParallel
+)
+
+// ColumnDef sets the columns of the text that follows.
+//
+// ColumnDef는 뒤따르는 텍스트의 단을 정합니다.
+type ColumnDef struct {
+	Property uint16
+
+	// Gap is the space between columns in HWPUNIT.
+	Gap int16
+
+	// Widths are the widths of the columns when they differ.
+	Widths []uint16
+
+	Property2 uint16
+
+	// The line between columns.
+	SeparatorType      LineType
+	SeparatorThickness LineThickness
+	SeparatorColor     ColorRef
+}
+
+func (*ColumnDef) CtrlID() CtrlID { return CtrlIDColumnDef }
+
+// Kind returns bits 0~1
+func (cd *ColumnDef) Kind() ColumnKind {
+	return ColumnKind(cd.Property & 3)
+}
+
+// Count returns bits 2~9, the number of columns.
+func (cd *ColumnDef) Count() int {
+	return int(cd.Property>>2) & 0xff
+}
+
+func (cd *ColumnDef) SameWidth() bool {
+	return cd.Property&(1<<12) != 0
+}
+
+func decodeColumnDef(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	cd := &ColumnDef{
+		Property: d.uint16(),
+		Gap:      d.int16(),
+	}
+	if !cd.SameWidth() {
+		for i := 0; i < cd.Count() && d.err == nil; i++ {
+			cd.Widths = append(cd.Widths, d.uint16())
+		}
+	}
+	cd.Property2 = d.uint16()
+	cd.SeparatorType = LineType(d.uint8())
+	cd.SeparatorThickness = LineThickness(d.uint8())
+	cd.SeparatorColor = ColorRef(d.uint32())
+	return cd, d.err
+}
+
+// PageKind is which pages something applies to.
+//
+// PageKind는 적용되는 쪽의 종류입니다.
+type PageKind uint8
+
+const (
+	PagesBoth PageKind = iota
+	PagesEven
+	PagesOdd
+)
+
+// HeaderFooter is a header or a footer. Its text is the list of paragraphs
+// under the CTRL_HEADER.
+//
+// HeaderFooter는 머리말이나 꼬리말입니다. 내용은 CTRL_HEADER 아래의 문단
+// 리스트입니다.
+type HeaderFooter struct {
+	// ID is CtrlIDHeader or CtrlIDFooter.
+	ID CtrlID
+
+	Property uint32
+}
+
+func (hf *HeaderFooter) CtrlID() CtrlID { return hf.ID }
+
+// Pages returns bits 0~1
+func (hf *HeaderFooter) Pages() PageKind {
+	return PageKind(hf.Property & 3)
+}
+
+func decodeHeaderFooter(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &HeaderFooter{
+		ID:       CtrlID(binary.LittleEndian.Uint32(ctrl.Data)),
+		Property: d.uint32(),
+	}, d.err
+}
+
+// Note is a footnote or an endnote. Its text is the list of paragraphs
+// under the CTRL_HEADER.
+//
+// Note는 각주나 미주입니다. 내용은 CTRL_HEADER 아래의 문단 리스트입니다.
+type Note struct {
+	// ID is CtrlIDFootnote or CtrlIDEndnote.
+	ID CtrlID
+
+	// Number is the number of the note as it was last laid out.
+	Number uint32
+
+	// Undefined holds the rest of the CTRL_HEADER, which the spec
+	// doesn't describe.
+	Undefined []byte
+}
+
+func (n *Note) CtrlID() CtrlID { return n.ID }
+
+func decodeNote(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	n := &Note{
+		ID:     CtrlID(binary.LittleEndian.Uint32(ctrl.Data)),
+		Number: d.uint32(),
+	}
+	n.Undefined = d.bytes(d.remaining())
+	return n, d.err
+}
+
+// NumberKind is what an auto number counts.
+//
+// NumberKind는 자동 번호가 세는 대상입니다.
+type NumberKind uint8
+
+const (
+	NumberPage NumberKind = iota
+	NumberFootnote
+	NumberEndnote
+	NumberPicture
+	NumberTable
+	NumberEquation
+)
+
+// AutoNumber is a number kept up to date by Hangul, like a page number in
+// the text.
+//
+// AutoNumber는 본문의 쪽 번호 같이 한글이 매기는 자동 번호입니다.
+type AutoNumber struct {
+	Property uint32
+
+	// Number is the number as it was last laid out.
+	Number uint16
+
+	// UserChar is the symbol used when the shape is a user symbol.
+	UserChar rune
+
+	Prefix, Suffix rune
+}
+
+func (*AutoNumber) CtrlID() CtrlID { return CtrlIDAutoNumber }
+
+// Kind returns bits 0~3
+func (an *AutoNumber) Kind() NumberKind {
+	return NumberKind(an.Property & 15)
+}
+
+// Shape returns bits 4~11
+func (an *AutoNumber) Shape() NumberShape {
+	return NumberShape(an.Property >> 4)
+}
+
+func (an *AutoNumber) Superscript() bool {
+	return an.Property&(1<<12) != 0
+}
+
+func decodeAutoNumber(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &AutoNumber{
+		Property: d.uint32(),
+		Number:   d.uint16(),
+		UserChar: rune(d.uint16()),
+		Prefix:   rune(d.uint16()),
+		Suffix:   rune(d.uint16()),
+	}, d.err
+}
+
+// NewNumber restarts the numbers of a kind at Number.
+//
+// NewNumber는 한 종류의 번호를 Number부터 다시 매깁니다.
+type NewNumber struct {
+	Property uint32
+
+	Number uint16
+}
+
+func (*NewNumber) CtrlID() CtrlID { return CtrlIDNewNumber }
+
+// Kind returns bits 0~3
+func (nn *NewNumber) Kind() NumberKind {
+	return NumberKind(nn.Property & 15)
+}
+
+func decodeNewNumber(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &NewNumber{
+		Property: d.uint32(),
+		Number:   d.uint16(),
+	}, d.err
+}
+
+// PageHide hides parts of the page it's on.
+//
+// PageHide는 컨트롤이 있는 쪽의 일부를 감춥니다.
+type PageHide struct {
+	Property uint32
+}
+
+func (*PageHide) CtrlID() CtrlID { return CtrlIDPageHide }
+
+func (ph *PageHide) HidesHeader() bool     { return ph.Property&(1<<0) != 0 }
+func (ph *PageHide) HidesFooter() bool     { return ph.Property&(1<<1) != 0 }
+func (ph *PageHide) HidesMasterPage() bool { return ph.Property&(1<<2) != 0 }
+func (ph *PageHide) HidesBorder() bool     { return ph.Property&(1<<3) != 0 }
+func (ph *PageHide) HidesFill() bool       { return ph.Property&(1<<4) != 0 }
+func (ph *PageHide) HidesPageNumber() bool { return ph.Property&(1<<5) != 0 }
+
+func decodePageHide(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &PageHide{Property: d.uint32()}, d.err
+}
+
+// PageAdjust makes the page it's on an even or an odd page.
+//
+// PageAdjust는 컨트롤이 있는 쪽을 짝수 쪽이나 홀수 쪽으로 맞춥니다.
+type PageAdjust struct {
+	Property uint32
+}
+
+func (*PageAdjust) CtrlID() CtrlID { return CtrlIDPageAdjust }
+
+// Pages returns bits 0~1
+func (pa *PageAdjust) Pages() PageKind {
+	return PageKind(pa.Property & 3)
+}
+
+func decodePageAdjust(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &PageAdjust{Property: d.uint32()}, d.err
+}
+
+// PageNumberPosition places the page numbers from the page it's on.
+//
+// PageNumberPosition은 컨트롤이 있는 쪽부터 쪽 번호의 위치를 정합니다.
+type PageNumberPosition struct {
+	Property uint32
+
+	UserChar       rune
+	Prefix, Suffix rune
+
+	// Dash is put on both sides of the number, usually '-'.
+	Dash rune
+}
+
+func (*PageNumberPosition) CtrlID() CtrlID { return CtrlIDPageNumberPosition }
+
+// Shape returns bits 0~7
+func (pn *PageNumberPosition) Shape() NumberShape {
+	return NumberShape(pn.Property)
+}
+
+// Position returns bits 8~11: 0 is no page number, then top left, top
+// center, top right, bottom left, bottom center, bottom right, outside top,
+// outside bottom, inside top and inside bottom.
+func (pn *PageNumberPosition) Position() uint8 {
+	return uint8(pn.Property>>8) & 15
+}
+
+func decodePageNumberPosition(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &PageNumberPosition{
+		Property: d.uint32(),
+		UserChar: rune(d.uint16()),
+		Prefix:   rune(d.uint16()),
+		Suffix:   rune(d.uint16()),
+		Dash:     rune(d.uint16()),
+	}, d.err
+}
+
+// IndexMark marks a place for the index with up to two keywords.
+//
+// IndexMark는 찾아보기 표시로, 키워드를 두 개까지 가집니다.
+type IndexMark struct {
+	Keyword1, Keyword2 string
+}
+
+func (*IndexMark) CtrlID() CtrlID { return CtrlIDIndexMark }
+
+func decodeIndexMark(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &IndexMark{
+		Keyword1: d.string(),
+		Keyword2: d.string(),
+	}, d.err
+}
+
+// Bookmark is a named place in the text.
+//
+// Bookmark는 본문의 이름 붙은 위치입니다.
+type Bookmark struct {
+	Name string
+}
+
+func (*Bookmark) CtrlID() CtrlID { return CtrlIDBookmark }
+
+func decodeBookmark(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	name, err := ctrlDataName(ctrl)
+	if err != nil {
+		return nil, err
+	}
+	return &Bookmark{Name: name}, nil
+}
+
+// ctrlDataName returns the name bookmarks and fields keep as item 0x4000
+// of the parameter set in their CTRL_DATA, or "" if there's none.
+func ctrlDataName(ctrl *RecordNode) (string, error) {
+	node := ctrl.Child(TagCtrlData)
+	if node == nil {
+		return "", nil
+	}
+	ps := new(ParameterSet)
+	err := ps.deserialize(node.Data)
+	if err != nil {
+		return "", err
+	}
+	item, ok := ps.Item(0x4000)
+	if !ok {
+		return "", nil
+	}
+	name, _ := item.Value.(string)
+	return name, nil
+}
+
+// CharOverlap draws chars on top of each other, like a number in a circle.
+//
+// CharOverlap은 원 안의 숫자처럼 글자들을 겹쳐 씁니다.
+type CharOverlap struct {
+	Text string
+
+	// BorderType is the shape drawn around the chars: 0 for none, then
+	// circle, reversed circle, square and reversed square.
+	BorderType uint8
+
+	// InnerSize is the size of the inner chars in percent.
+	InnerSize int8
+
+	// Expansion spreads the chars when set.
+	Expansion int8
+
+	// CharShapeIDs are the char shapes of the inner chars.
+	CharShapeIDs []uint32
+}
+
+func (*CharOverlap) CtrlID() CtrlID { return CtrlIDCharOverlap }
+
+func decodeCharOverlap(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	co := &CharOverlap{
+		Text:       d.string(),
+		BorderType: d.uint8(),
+		InnerSize:  d.int8(),
+		Expansion:  d.int8(),
+	}
+	n := int(d.uint8())
+	for i := 0; i < n && d.err == nil; i++ {
+		co.CharShapeIDs = append(co.CharShapeIDs, d.uint32())
+	}
+	return co, d.err
+}
+
+// Dutmal is text with a smaller text above or below it (덧말), like ruby.
+//
+// Dutmal은 본말 위나 아래에 작은 덧말을 단 텍스트입니다.
+type Dutmal struct {
+	Main string
+	Sub  string
+
+	// Position is 0 when Sub is above Main and 1 when it's below.
+	Position uint32
+
+	// SizeRatio is the size of Sub relative to Main in percent.
+	SizeRatio uint32
+
+	Option uint32
+
+	StyleID uint32
+
+	Alignment Alignment
+}
+
+func (*Dutmal) CtrlID() CtrlID { return CtrlIDDutmal }
+
+func decodeDutmal(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	return &Dutmal{
+		Main:      d.string(),
+		Sub:       d.string(),
+		Position:  d.uint32(),
+		SizeRatio: d.uint32(),
+		Option:    d.uint32(),
+		StyleID:   d.uint32(),
+		Alignment: Alignment(d.uint32()),
+	}, d.err
+}
+
+// HiddenComment is a comment kept out of the printed text. Its text is the
+// list of paragraphs under the CTRL_HEADER.
+//
+// HiddenComment는 인쇄되지 않는 숨은 설명입니다. 내용은 CTRL_HEADER 아래의
+// 문단 리스트입니다.
+type HiddenComment struct{}
+
+func (*HiddenComment) CtrlID() CtrlID { return CtrlIDHiddenComment }
+
+func decodeHiddenComment(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	return &HiddenComment{}, nil
+}
+
+// Field is the start of a field, such as a hyperlink or a click here
+// field. The text of the field runs up to the matching field end in the
+// paragraph.
+//
+// Field는 하이퍼링크, 누름틀 같은 필드의 시작입니다. 필드의 텍스트는
+// 문단에서 짝이 되는 필드 끝까지입니다.
+type Field struct {
+	// ID is one of the CtrlIDField constants.
+	ID CtrlID
+
+	Property uint32
+
+	ExtraProperty uint8
+
+	// Command is what the field does, e.g. the address of a hyperlink.
+	Command string
+
+	InstanceID uint32
+
+	// Name is the name of the field, if it has one.
+	Name string
+}
+
+func (f *Field) CtrlID() CtrlID { return f.ID }
+
+// Editable reports if the field can be changed in read only mode.
+func (f *Field) Editable() bool {
+	return f.Property&(1<<0) != 0
+}
+
+func decodeField(ctrl *RecordNode, ver FileVersion) (Control, error) {
+	d := ctrlDataReader(ctrl)
+	f := &Field{
+		ID:            CtrlID(binary.LittleEndian.Uint32(ctrl.Data)),
+		Property:      d.uint32(),
+		ExtraProperty: d.uint8(),
+		Command:       d.string(),
+		InstanceID:    d.uint32(),
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	name, err := ctrlDataName(ctrl)
+	if err != nil {
+		return nil, err
+	}
+	f.Name = name
+	return f, nil
+}
+
+func init() {
+	for id, dec := range map[CtrlID]ControlDecoder{
+		CtrlIDTable:              decodeObject,
+		CtrlIDShapeObject:        decodeObject,
+		CtrlIDEquation:           decodeObject,
+		CtrlIDSectionDef:         decodeSectionDef,
+		CtrlIDColumnDef:          decodeColumnDef,
+		CtrlIDHeader:             decodeHeaderFooter,
+		CtrlIDFooter:             decodeHeaderFooter,
+		CtrlIDFootnote:           decodeNote,
+		CtrlIDEndnote:            decodeNote,
+		CtrlIDAutoNumber:         decodeAutoNumber,
+		CtrlIDNewNumber:          decodeNewNumber,
+		CtrlIDPageHide:           decodePageHide,
+		CtrlIDPageAdjust:         decodePageAdjust,
+		CtrlIDPageNumberPosition: decodePageNumberPosition,
+		CtrlIDIndexMark:          decodeIndexMark,
+		CtrlIDBookmark:           decodeBookmark,
+		CtrlIDCharOverlap:        decodeCharOverlap,
+		CtrlIDDutmal:             decodeDutmal,
+		CtrlIDHiddenComment:      decodeHiddenComment,
+	} {
+		RegisterControlDecoder(id, dec)
+	}
+}
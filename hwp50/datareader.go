@@ -0,0 +1,119 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+)
+
+// dataReader reads little endian values out of the data of a record. The
+// first short read sticks in err and every read after it returns zero
+// values, so decoders can read all their fields and check err once.
+type dataReader struct {
+	b   []byte
+	off int
+	err error
+}
+
+func newDataReader(b []byte) *dataReader {
+	return &dataReader{b: b}
+}
+
+// next returns the next n bytes or nil if there aren't enough left.
+func (d *dataReader) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.b)-d.off < n {
+		d.err = fmt.Errorf("%w: need %d bytes at %d, have %d",
+			ErrTruncatedRecord, n, d.off, len(d.b)-d.off)
+		return nil
+	}
+	b := d.b[d.off : d.off+n]
+	d.off += n
+	return b
+}
+
+// remaining returns how many bytes are left to read.
+func (d *dataReader) remaining() int {
+	if d.err != nil {
+		return 0
+	}
+	return len(d.b) - d.off
+}
+
+func (d *dataReader) skip(n int) {
+	d.next(n)
+}
+
+func (d *dataReader) bytes(n int) []byte {
+	b := d.next(n)
+	if b == nil {
+		return nil
+	}
+	return append([]byte(nil), b...)
+}
+
+func (d *dataReader) uint8() uint8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (d *dataReader) uint16() uint16 {
+	b := d.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (d *dataReader) uint32() uint32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (d *dataReader) int8() int8 {
+	return int8(d.uint8())
+}
+
+func (d *dataReader) int16() int16 {
+	return int16(d.uint16())
+}
+
+func (d *dataReader) int32() int32 {
+	return int32(d.uint32())
+}
+
+// wchars reads n UTF-16LE code units.
+func (d *dataReader) wchars(n int) []wchar {
+	b := d.next(n * 2)
+	if b == nil {
+		return nil
+	}
+	w := make([]wchar, n)
+	for i := range w {
+		w[i] = wchar(binary.LittleEndian.Uint16(b[i*2:]))
+	}
+	return w
+}
+
+// string reads a WORD length followed by that many WCHARs.
+func (d *dataReader) string() string {
+	n := int(d.uint16())
+	return wcharsToString(d.wchars(n))
+}
+
+// wcharsToString converts UTF-16 code units into a Go string.
+func wcharsToString(w []wchar) string {
+	u := make([]uint16, len(w))
+	for i, c := range w {
+		u[i] = uint16(c)
+	}
+	return string(utf16.Decode(u))
+}
@@ -1,6 +1,9 @@
 package hwp50
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // DocInfo saves information about font, tab, styling, etc
 //
@@ -13,25 +16,103 @@ type DocInfo struct {
 	// IDMappings
 	IDMappings IDMappings
 
-	BinData []byte
-
-	FaceName            []byte
-	BorderFill          []byte
-	CharShape           [72]byte
-	TabDef              [14]byte
-	Numbering           []byte
-	Bullet              [10]byte
-	ParagraphShape      [54]byte
-	Style               []byte
-	MemoShape           [22]byte
-	TrackChangeAuthor   []byte
-	FirstTrackChange    []byte
-	DocData             []byte
-	ForbiddenChar       []byte
-	CompatibleDocument  [4]byte
-	LayoutCompatibility [20]byte
-	DistributeDocData   [256]byte
-	SecondTrackChange   [1032]byte
+	// BinData holds the pictures and OLE objects of the document. The ids
+	// the body text uses start at 1.
+	//
+	// BinData는 문서의 그림과 OLE 개체 정보를 담고 있습니다. 본문이 쓰는
+	// id는 1부터 시작합니다.
+	BinData []BinData
+
+	// FaceName holds the fonts of each language group, indexed by
+	// FontLanguage.
+	//
+	// FaceName은 언어 그룹별 글꼴을 FontLanguage 순서로 담고 있습니다.
+	FaceName [fontLanguages][]*FaceName
+
+	// BorderFill holds the border and fill settings. The ids used by the
+	// body text start at 1.
+	//
+	// BorderFill은 테두리/배경 설정을 담고 있습니다. 본문이 쓰는 id는
+	// 1부터 시작합니다.
+	BorderFill []*BorderFill
+
+	// CharShape holds the char shapes, indexed by the ids paragraphs use.
+	//
+	// CharShape는 문단이 쓰는 id 순서로 글자 모양을 담고 있습니다.
+	CharShape []*CharShape
+
+	// TabDef holds the tab stops, indexed by the ids para shapes use.
+	//
+	// TabDef는 문단 모양이 쓰는 id 순서로 탭 정의를 담고 있습니다.
+	TabDef []*TabDef
+
+	// Numbering holds the numberings. The ids para shapes and sections
+	// use start at 1.
+	//
+	// Numbering은 문단 번호 정의를 담고 있습니다. 문단 모양과 구역이
+	// 쓰는 id는 1부터 시작합니다.
+	Numbering []*Numbering
+
+	// Bullet holds the bullets. The ids para shapes use start at 1.
+	//
+	// Bullet은 글머리표를 담고 있습니다. 문단 모양이 쓰는 id는 1부터
+	// 시작합니다.
+	Bullet []*Bullet
+
+	// ParagraphShape holds the para shapes, indexed by the ids paragraphs
+	// use.
+	//
+	// ParagraphShape는 문단이 쓰는 id 순서로 문단 모양을 담고 있습니다.
+	ParagraphShape []*ParaShape
+
+	// Style holds the styles, indexed by the ids paragraphs use.
+	//
+	// Style은 문단이 쓰는 id 순서로 스타일을 담고 있습니다.
+	Style []*Style
+
+	// MemoShape holds the looks of the memo boxes. There are
+	// IDMappings.MemoShape of them.
+	//
+	// MemoShape는 메모 상자 모양을 담고 있습니다. IDMappings.MemoShape개가
+	// 있습니다.
+	MemoShape []*MemoShape
+
+	// TrackChangeConfig holds the track change settings.
+	//
+	// TrackChangeConfig는 변경 추적 설정을 담고 있습니다.
+	TrackChangeConfig TrackChangeConfig
+
+	// TrackChanges holds the tracked changes and TrackChangeAuthor the
+	// people who made them. There are IDMappings.TrackChanges and
+	// IDMappings.TrackChangeAuthor of them.
+	//
+	// TrackChanges는 추적된 변경을, TrackChangeAuthor는 변경한 사람을
+	// 담고 있습니다. 각각 IDMappings.TrackChanges개,
+	// IDMappings.TrackChangeAuthor개가 있습니다.
+	TrackChanges      []*TrackChange
+	TrackChangeAuthor []*TrackChangeAuthor
+
+	// DocData holds document settings like the print settings and the
+	// last edit position.
+	//
+	// DocData는 인쇄 설정, 마지막 편집 위치 같은 문서 설정을 담고
+	// 있습니다.
+	DocData *ParameterSet
+
+	// ForbiddenChar holds the chars that may not start or end a line.
+	//
+	// ForbiddenChar는 줄 처음과 끝에 올 수 없는 글자들입니다.
+	ForbiddenChar ForbiddenChar
+
+	// CompatibleDocument and LayoutCompatibility tell which program the
+	// layout of the document follows.
+	//
+	// CompatibleDocument와 LayoutCompatibility는 문서의 조판이 따르는
+	// 프로그램을 나타냅니다.
+	CompatibleDocument  CompatibleDocument
+	LayoutCompatibility LayoutCompatibility
+
+	DistributeDocData [256]byte
 }
 
 // DocumentProperites is the property of the current hwp 5.0 file
@@ -91,25 +172,49 @@ type DocumentProperites struct {
 	WordUnitLocationInParagraph uint32
 }
 
-// IDMappings is a pointer to the elements
+func (dp *DocumentProperites) deserialize(data []byte) error {
+	d := newDataReader(data)
+	dp.SectionNum = d.uint16()
+	dp.PageStartNum = d.uint16()
+	dp.FootNoteStartNum = d.uint16()
+	dp.EndNoteStartNum = d.uint16()
+	dp.PictureStartNum = d.uint16()
+	dp.ChartStartNum = d.uint16()
+	dp.EquationStartNum = d.uint16()
+	dp.ListID = d.uint32()
+	dp.ParagraphID = d.uint32()
+	dp.WordUnitLocationInParagraph = d.uint32()
+	return d.err
+}
+
+func decodeDocumentProperties(rec *Record, ver FileVersion) (interface{}, error) {
+	dp := new(DocumentProperites)
+	err := dp.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return dp, nil
+}
+
+// IDMappings holds how many records of each kind follow in the DocInfo
+// stream. The ids used by the body text (e.g. the char shape of a run)
+// are indexes into those records.
 //
-// IDMappings은 이
+// IDMappings는 DocInfo 스트림에 각 종류의 레코드가 몇 개 뒤따르는지를 담고
+// 있습니다. 본문이 쓰는 id(예: 글자 모양 id)는 이 레코드들의 순번입니다.
 type IDMappings struct {
 	BinData int32
 
-	KoreanFont int32
-
-	EnglishFont int32
-
-	HanjaFont int32
-
-	JapaneseFont int32
-
-	EtcFont int32
-
+	// The font counts, one per FontLanguage.
+	//
+	// FontLanguage별 글꼴 개수입니다.
+	KoreanFont    int32
+	EnglishFont   int32
+	HanjaFont     int32
+	JapaneseFont  int32
+	EtcFont       int32
 	CharacterFont int32
-
-	UserFont int32
+	UserFont      int32
 
 	BorderAndBackground int32
 
@@ -119,6 +224,10 @@ type IDMappings struct {
 
 	ParagraphNum int32
 
+	Bullet int32
+
+	ParaShape int32
+
 	Style int32
 
 	// Only relevant for hwp 5.0.2.1 and up
@@ -131,167 +240,273 @@ type IDMappings struct {
 	TrackChangeAuthor int32
 }
 
-// BinData stores data about pictures, OLE, etc.
-type BinData struct {
-	// Stores information on whehter BinData has compression,
-	// images, and whether it was accssed before
-	Property uint16
-
-	// length of the absolute path
-	// Only relevant when Type is "LINK"
-	AbsLen uint16
-
-	// location of the absolute path
-	// ABSLen * 2 is the size
-	// Only relevant when Type is "LINK"
-	AbsLoc []byte
-
-	// length of the relative path
-	// Only relevant when Type is "LINK"
-	RelLen uint16
-
-	// location of the relative path
-	// RelLen * 2 is the size
-	// Only relevant when Type is "LINK"
-	RelLoc []byte
-
-	// Binary data's stored ID in BINDATASTORAGE
-	// Only relevant when type is "EMBEDDING" or "STORAGE"
-	BinDataID uint16
-
-	// Only relevant when type is "EMBEDDING" or "STORAGE"
-	BinDataNameLen uint16
-
-	// Extension type
-	// BinDataNameLen * 2 is the size
-	// Only relevant when type is "EMBEDDING"
-	// Available picture types: jpg, bmp, gif
-	// Available OLE type: ole
-	ExtType []byte
-}
-
-func (bd *BinData) HasExternalPicture() bool {
-	// turn on bits 0~3
-	mask := uint16(15)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 0
-}
-
-func (bd *BinData) HasEmbeddedPicture() bool {
-	// turn on bits 0~3
-	mask := uint16(15)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 1
-}
-
-func (bd *BinData) HasStorage() bool {
-	// turn on bits 0~3
-	mask := uint16(15)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 2
-}
-
-func (bd *BinData) IsDefultStorageMode() bool {
-	// turn on bits 4~5
-	mask := uint16(3 << 4)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 0
-}
-
-func (bd *BinData) AlwaysCompress() bool {
-	// turn on bits 4~5
-	mask := uint16(3 << 4)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 1
-}
-
-func (bd *BinData) NeverCompress() bool {
-	// turn on bits 4~5
-	mask := uint16(3 << 4)
+// FontCount returns the number of FACE_NAME records, which is the sum of
+// the font counts of every language.
+//
+// FontCount는 모든 언어의 글꼴 개수를 더한 FACE_NAME 레코드 개수를
+// 반환합니다.
+func (m *IDMappings) FontCount() int32 {
+	var sum int32
+	for _, n := range m.fontCounts() {
+		sum += n
+	}
+	return sum
+}
+
+// deserialize reads the counts. The ones up to Style are always there;
+// MemoShape and the track change counts are only read when the version has
+// them and the record is long enough, since some writers leave them out.
+func (m *IDMappings) deserialize(data []byte, ver FileVersion) error {
+	d := newDataReader(data)
+	for _, n := range []*int32{
+		&m.BinData,
+		&m.KoreanFont, &m.EnglishFont, &m.HanjaFont, &m.JapaneseFont,
+		&m.EtcFont, &m.CharacterFont, &m.UserFont,
+		&m.BorderAndBackground, &m.LetterShape, &m.TabDef,
+		&m.ParagraphNum, &m.Bullet, &m.ParaShape, &m.Style,
+	} {
+		*n = d.int32()
+	}
 
-	// take only the 1~3 bits
-	value := mask & bd.Property
+	if ver.AtLeast(5, 0, 2, 1) && d.remaining() >= 4 {
+		m.MemoShape = d.int32()
+	}
+	if ver.AtLeast(5, 0, 3, 2) && d.remaining() >= 8 {
+		m.TrackChanges = d.int32()
+		m.TrackChangeAuthor = d.int32()
+	}
 
-	return value == 2
+	return d.err
 }
 
-func (bd *BinData) NeverAccessed() bool {
-	// turn on bits 8~9
-	mask := uint16(3 << 8)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 0
+func decodeIDMappings(rec *Record, ver FileVersion) (interface{}, error) {
+	m := new(IDMappings)
+	err := m.deserialize(rec.Data, ver)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (bd *BinData) AccessSucessful() bool {
-	// turn on bits 8~9
-	mask := uint16(3 << 8)
-
-	// take only the 1~3 bits
-	value := mask & bd.Property
-
-	return value == 1
+func init() {
+	registerDecoder(TagDocumentProperties, decodeDocumentProperties)
+	registerDecoder(TagIDMappings, decodeIDMappings)
+	registerDecoder(TagFaceName, decodeFaceName)
 }
 
-func (bd *BinData) AccessFailed() bool {
-	// turn on bits 8~9
-	mask := uint16(3 << 8)
+// ErrRecordCount is returned when the number of records of a kind in the
+// DocInfo stream doesn't match ID_MAPPINGS, which happens when the stream
+// is truncated or was tampered with.
+//
+// ErrRecordCount는 DocInfo 스트림의 레코드 개수가 ID_MAPPINGS와 다를 때
+// 반환됩니다. 스트림이 잘렸거나 변조된 경우입니다.
+var ErrRecordCount = errors.New("record count doesn't match ID_MAPPINGS " +
+	"레코드 개수가 ID_MAPPINGS와 다릅니다")
+
+// decode fills di from the records of the DocInfo stream.
+func (di *DocInfo) decode(records []*Record, ver FileVersion) error {
+	var (
+		mappings  *IDMappings
+		faceNames []*FaceName
+	)
+	for _, rec := range records {
+		v, err := DecodeRecord(rec, ver)
+		if errors.Is(err, ErrNoDecoder) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		switch v := v.(type) {
+		case *DocumentProperites:
+			di.DocumentProperites = *v
+		case *IDMappings:
+			di.IDMappings = *v
+			mappings = v
+		case *BinData:
+			di.BinData = append(di.BinData, *v)
+		case *FaceName:
+			faceNames = append(faceNames, v)
+		case *BorderFill:
+			di.BorderFill = append(di.BorderFill, v)
+		case *CharShape:
+			di.CharShape = append(di.CharShape, v)
+		case *TabDef:
+			di.TabDef = append(di.TabDef, v)
+		case *Numbering:
+			di.Numbering = append(di.Numbering, v)
+		case *Bullet:
+			di.Bullet = append(di.Bullet, v)
+		case *ParaShape:
+			di.ParagraphShape = append(di.ParagraphShape, v)
+		case *Style:
+			di.Style = append(di.Style, v)
+		case *ParameterSet:
+			di.DocData = v
+		case *ForbiddenChar:
+			di.ForbiddenChar = *v
+		case *CompatibleDocument:
+			di.CompatibleDocument = *v
+		case *LayoutCompatibility:
+			di.LayoutCompatibility = *v
+		case *MemoShape:
+			di.MemoShape = append(di.MemoShape, v)
+		case *TrackChangeConfig:
+			di.TrackChangeConfig = *v
+		case *TrackChange:
+			di.TrackChanges = append(di.TrackChanges, v)
+		case *TrackChangeAuthor:
+			di.TrackChangeAuthor = append(di.TrackChangeAuthor, v)
+		}
+	}
 
-	// take only the 1~3 bits
-	value := mask & bd.Property
+	if mappings == nil {
+		return nil
+	}
+	err := mappings.check(records)
+	if err != nil {
+		return err
+	}
 
-	return value == 2
+	di.groupFaceNames(faceNames)
+	return nil
 }
 
-func (bd *BinData) AccessFailedAndErrorIgnored() bool {
-	// turn on bits 8~9
-	mask := uint16(3 << 8)
+// check compares the counts of m with the records that follow it. The font
+// counts are checked one by one too, since a negative one could still add
+// up to the number of FACE_NAME records.
+func (m *IDMappings) check(records []*Record) error {
+	for lang, n := range m.fontCounts() {
+		if n < 0 {
+			return fmt.Errorf("%w: %v fonts: negative count %d",
+				ErrRecordCount, FontLanguage(lang), n)
+		}
+	}
 
-	// take only the 1~3 bits
-	value := mask & bd.Property
+	counts := make(map[TagID]int32)
+	for _, rec := range records {
+		counts[rec.TagID]++
+	}
 
-	return value == 3
+	for _, c := range []struct {
+		tag  TagID
+		want int32
+	}{
+		{TagBinData, m.BinData},
+		{TagFaceName, m.FontCount()},
+		{TagBorderFill, m.BorderAndBackground},
+		{TagCharShape, m.LetterShape},
+		{TagTabDef, m.TabDef},
+		{TagNumbering, m.ParagraphNum},
+		{TagBullet, m.Bullet},
+		{TagParaShape, m.ParaShape},
+		{TagStyle, m.Style},
+		{TagMemoShape, m.MemoShape},
+		{TagTrackChangeContent, m.TrackChanges},
+		{TagTrackChangeAuthor, m.TrackChangeAuthor},
+	} {
+		if got := counts[c.tag]; got != c.want {
+			return fmt.Errorf("%w: %v: want %d, got %d",
+				ErrRecordCount, c.tag, c.want, got)
+		}
+	}
+	return nil
 }
 
-func (bd *BinData) GetAbsLocSize() uint16 {
-	return bd.AbsLen * 2
+// FontLanguage is one of the language groups fonts are kept in. A char
+// shape picks one font per group.
+//
+// FontLanguage는 글꼴이 나뉘어 담기는 언어 그룹입니다. 글자 모양은 그룹마다
+// 글꼴을 하나씩 고릅니다.
+type FontLanguage int
+
+const (
+	FontHangul FontLanguage = iota
+	FontLatin
+	FontHanja
+	FontJapanese
+	FontOther
+	FontSymbol
+	FontUser
+
+	// fontLanguages is the number of language groups.
+	fontLanguages = 7
+)
+
+var fontLanguageNames = [fontLanguages]string{
+	"hangul", "latin", "hanja", "japanese", "other", "symbol", "user",
+}
+
+func (l FontLanguage) String() string {
+	if l < 0 || l >= fontLanguages {
+		return fmt.Sprintf("FontLanguage(%d)", int(l))
+	}
+	return fontLanguageNames[l]
 }
 
-func (bd *BinData) GetRelLocSize() uint16 {
-	return bd.RelLen * 2
+// SubstituteFontType is the kind of the font used when a font is missing.
+//
+// SubstituteFontType은 글꼴이 없을 때 쓰는 대체 글꼴의 종류입니다.
+type SubstituteFontType uint8
+
+const (
+	SubstituteUnknown SubstituteFontType = iota
+	SubstituteTTF
+	SubstituteHFT
+)
+
+func (t SubstituteFontType) String() string {
+	switch t {
+	case SubstituteUnknown:
+		return "unknown"
+	case SubstituteTTF:
+		return "TTF"
+	case SubstituteHFT:
+		return "HFT"
+	}
+	return fmt.Sprintf("SubstituteFontType(%d)", uint8(t))
 }
 
-func (bd *BinData) GetExtTypeSize() uint16 {
-	return bd.BinDataNameLen * 2
-}
+// Panose is the type info of a font, laid out like the PANOSE
+// classification.
+//
+// Panose는 PANOSE 분류와 같은 형식의 글꼴 유형 정보입니다.
+type Panose struct {
+	FamilyType      uint8
+	SerifStyle      uint8
+	Weight          uint8
+	Proportion      uint8
+	Contrast        uint8
+	StrokeVariation uint8
+	ArmStyle        uint8
+	Letterform      uint8
+	Midline         uint8
+	XHeight         uint8
+}
+
+// Bits of FaceName.Property telling which parts follow the name.
+const (
+	faceNameHasDefault    = 1 << 5
+	faceNameHasFaceType   = 1 << 6
+	faceNameHasSubstitute = 1 << 7
+)
 
 // FaceName stores information about the font used
+//
+// FaceName은 글꼴 정보를 담고 있습니다.
 type FaceName struct {
-	//
+	// Property tells which of the optional parts below are present.
 	Property byte
 
 	// Length of the FName
 	FNameLen uint16
 
 	// Name of the font used
-	FName []byte
+	FName string
+
+	// Type of the substitute font
+	SubFType SubstituteFontType
 
 	// Length of the substitute FName when FName is not
 	// available
@@ -299,195 +514,119 @@ type FaceName struct {
 
 	// Name of the substitute font
 	// Used when FName is not available
-	SubFName []byte
+	SubFName string
 
-	// Property of the face used
-	FaceType [10]byte
+	// Type info of the face used
+	FaceType Panose
 
 	// Default face name length
 	DefaultFNameLen uint16
 
 	// Name of the default font
-	DefaultFName []byte
+	DefaultFName string
 }
 
-func (fn *FaceName) GetFaceNameLen() uint16 {
-	return fn.FNameLen
+// HasSubstitute reports if the font has a substitute font.
+func (fn *FaceName) HasSubstitute() bool {
+	return fn.Property&faceNameHasSubstitute != 0
 }
 
-func (fn *FaceName) GetSubFNameLen() uint16 {
-	return fn.SubFNameLen
+// HasFaceType reports if FaceType is set.
+func (fn *FaceName) HasFaceType() bool {
+	return fn.Property&faceNameHasFaceType != 0
 }
 
-func (fn *FaceName) GetDefaultFNameLen() uint16 {
-	return fn.DefaultFNameLen
+// HasDefault reports if the font has a default font.
+func (fn *FaceName) HasDefault() bool {
+	return fn.Property&faceNameHasDefault != 0
 }
 
-type BorderFill struct {
-	// Implements "Chart 24" in the hwp50 specs
-	Property uint16
-
-	// Implements "Chart 25" in the hwp50 specs
-	BorderType uint32
-
-	// Implements "Chart 26" in the hwp50 specs
-	BorderThickness uint32
-
-	BorderColor [4]uint32
-
-	// Implements "Chart 27" in the hwp50 specs
-	DiagonalLineType uint8
-
-	DiagonalLineThickness uint8
-
-	DiagonalLineColor uint32
-
-	// Implements "Chart 28" in the hwp50 specs
-	FillInfo []byte
+func (fn *FaceName) GetFaceNameLen() uint16 {
+	return fn.FNameLen
 }
 
-func (bf *BorderFill) Has3DEffect() bool {
-	// turn on the 0th bit
-	mask := uint16(1)
-
-	value := mask & bf.Property
-
-	return value == 1
+func (fn *FaceName) GetSubFNameLen() uint16 {
+	return fn.SubFNameLen
 }
 
-func (bf *BorderFill) HasShadows() bool {
-	// turn on the 1st bit
-	mask := uint16(2)
-	value := mask & bf.Property
-	return value == 1
+func (fn *FaceName) GetDefaultFNameLen() uint16 {
+	return fn.DefaultFNameLen
 }
 
-func (bf *BorderFill) GetSlashShape() uint16 {
-	// turn on bits 2~4
-	mask := uint16(7 << 2)
-	value := mask & bf.Property
-	return value
-}
+func (fn *FaceName) deserialize(data []byte) error {
+	d := newDataReader(data)
+	fn.Property = d.uint8()
+	fn.FNameLen = d.uint16()
+	fn.FName = wcharsToString(d.wchars(int(fn.FNameLen)))
 
-func (bf *BorderFill) GetBackSlashShape() uint16 {
-	// turn on bits 5~7
-	mask := uint16(7 << 5)
-	value := mask & bf.Property
-	return value
-}
-func (bf *BorderFill) IsSlashBent() uint16 {
-	// turn on bits 8~9
-	mask := uint16(3 << 9)
-	value := mask & bf.Property
-	return value
-}
-func (bf *BorderFill) IsBackSlashBent() bool {
-	// turn on bit 10
-	mask := uint16(1 << 10)
-	value := mask & bf.Property
-	return value == 1
-}
-func (bf *BorderFill) IsSlash180flipped() bool {
-	mask := uint16(1 << 11)
-	value := mask & bf.Property
-	return value == 1
-}
+	if fn.HasSubstitute() {
+		fn.SubFType = SubstituteFontType(d.uint8())
+		fn.SubFNameLen = d.uint16()
+		fn.SubFName = wcharsToString(d.wchars(int(fn.SubFNameLen)))
+	}
 
-func (bf *BorderFill) IsBackSlash180flipped() bool {
-	mask := uint16(1 << 12)
-	value := mask & bf.Property
-	return value == 1
-}
+	if fn.HasFaceType() {
+		p := &fn.FaceType
+		for _, b := range []*uint8{
+			&p.FamilyType, &p.SerifStyle, &p.Weight, &p.Proportion,
+			&p.Contrast, &p.StrokeVariation, &p.ArmStyle, &p.Letterform,
+			&p.Midline, &p.XHeight,
+		} {
+			*b = d.uint8()
+		}
+	}
 
-func (bf *BorderFill) HasCenterLine() bool {
-	mask := uint16(1 << 13)
-	value := mask & bf.Property
-	return value == 1
-}
+	if fn.HasDefault() {
+		fn.DefaultFNameLen = d.uint16()
+		fn.DefaultFName = wcharsToString(d.wchars(int(fn.DefaultFNameLen)))
+	}
 
-// TODO chart 25
-func (bf *BorderFill) GetLineType() {
+	return d.err
 }
 
-// GetBorderThickness returns the thickness of the border in
-// milimeters.
-func (bf *BorderFill) GetBorderThickness() (float32, error) {
-	if bf.BorderThickness == 0 {
-		return 0.1, nil
-	}
-	if bf.BorderThickness == 1 {
-		return 0.12, nil
-	}
-	if bf.BorderThickness == 2 {
-		return 0.15, nil
-	}
-	if bf.BorderThickness == 3 {
-		return 0.2, nil
-	}
-	if bf.BorderThickness == 4 {
-		return 0.25, nil
-	}
-	if bf.BorderThickness == 5 {
-		return 0.3, nil
-	}
-	if bf.BorderThickness == 6 {
-		return 0.4, nil
-	}
-	if bf.BorderThickness == 7 {
-		return 0.5, nil
+func decodeFaceName(rec *Record, ver FileVersion) (interface{}, error) {
+	fn := new(FaceName)
+	err := fn.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
 	}
-	if bf.BorderThickness == 8 {
-		return 0.6, nil
-	}
-	if bf.BorderThickness == 9 {
-		return 0.7, nil
-	}
-	if bf.BorderThickness == 10 {
-		return 1.0, nil
-	}
-	if bf.BorderThickness == 11 {
-		return 1.5, nil
-	}
-	if bf.BorderThickness == 12 {
-		return 2.0, nil
-	}
-	if bf.BorderThickness == 13 {
-		return 3.0, nil
-	}
-	if bf.BorderThickness == 14 {
-		return 4.0, nil
-	}
-	if bf.BorderThickness == 15 {
-		return 5.0, nil
-	}
-	return 0, fmt.Errorf("GetBorderThickness err")
+	return fn, nil
 }
 
-func (bf *BorderFill) GetDiagonalLineType() (string, error) {
-	if bf.DiagonalLineType == 0 {
-		return "Slash", nil
-	}
-	if bf.DiagonalLineType == 0 {
-		return "BackSlash", nil
+// fontCounts returns the number of fonts of every language group.
+func (m *IDMappings) fontCounts() [fontLanguages]int32 {
+	return [fontLanguages]int32{
+		m.KoreanFont, m.EnglishFont, m.HanjaFont, m.JapaneseFont,
+		m.EtcFont, m.CharacterFont, m.UserFont,
 	}
-	if bf.DiagonalLineType == 0 {
-		return "CrookedSlash", nil
-	}
-	return "", fmt.Errorf("GetDiagonalLineType err")
 }
 
-func (bf *BorderFill) GetFillInfo() {
-	//var fillType uint
-	if bf.FillInfo[0] == 0 {
+// groupFaceNames splits the FACE_NAME records, which come one language
+// after another, into their language groups.
+func (di *DocInfo) groupFaceNames(faceNames []*FaceName) {
+	for lang, n := range di.IDMappings.fontCounts() {
+		if n < 0 {
+			n = 0
+		}
+		if int(n) > len(faceNames) {
+			n = int32(len(faceNames))
+		}
+		di.FaceName[lang] = faceNames[:n]
+		faceNames = faceNames[n:]
 	}
 }
 
-func getSolidFillInfo() {
-
-}
-
-func getGradFillInfo() {
-}
-
-func getImageFillInfo() {
+// Font returns the font with the given id of a language group, as used by
+// char shapes.
+//
+// Font는 글자 모양이 가리키는 언어 그룹의 id번째 글꼴을 반환합니다.
+func (di *DocInfo) Font(lang FontLanguage, id uint16) (*FaceName, bool) {
+	if lang < 0 || lang >= fontLanguages {
+		return nil, false
+	}
+	fonts := di.FaceName[lang]
+	if int(id) >= len(fonts) {
+		return nil, false
+	}
+	return fonts[id], true
 }
@@ -0,0 +1,204 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// TestDecodeDocInfo checks DOCUMENT_PROPERTIES and ID_MAPPINGS of the
+// testdata file.
+//
+// TestDecodeDocInfo는 testdata 파일의 DOCUMENT_PROPERTIES와 ID_MAPPINGS를
+// 확인합니다.
+func TestDecodeDocInfo(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dp := hwp.DocInfo.DocumentProperites
+	if dp.SectionNum != 1 || dp.PageStartNum != 1 || dp.FootNoteStartNum != 1 {
+		t.Errorf("unexpected document properties %+v", dp)
+	}
+
+	m := hwp.DocInfo.IDMappings
+	want := IDMappings{
+		KoreanFont: 2, EnglishFont: 2, HanjaFont: 2, JapaneseFont: 2,
+		EtcFont: 2, CharacterFont: 2, UserFont: 2,
+		BorderAndBackground: 2, LetterShape: 5, TabDef: 2,
+		ParagraphNum: 1, ParaShape: 12, Style: 14,
+	}
+	if m != want {
+		t.Errorf("expected %+v, got %+v", want, m)
+	}
+	if m.FontCount() != 14 {
+		t.Errorf("expected 14 fonts, got %d", m.FontCount())
+	}
+}
+
+// idMappingsData returns the data of an ID_MAPPINGS record with n counts
+// numbered from 1.
+func idMappingsData(n int) []byte {
+	b := make([]byte, 4*n)
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint32(b[4*i:], uint32(i+1))
+	}
+	return b
+}
+
+// TestIDMappingsVersions checks that the counts added in later versions are
+// only read when the version has them.
+//
+// TestIDMappingsVersions는 나중 버전에 추가된 개수를 그 버전부터만 읽는지
+// 확인합니다.
+func TestIDMappingsVersions(t *testing.T) {
+	tests := []struct {
+		ver               FileVersion
+		n                 int
+		memo, changes, by int32
+	}{
+		{FileVersion{5, 0, 1, 7}, 15, 0, 0, 0},
+		{FileVersion{5, 0, 1, 7}, 18, 0, 0, 0},
+		{FileVersion{5, 0, 2, 1}, 16, 16, 0, 0},
+		{FileVersion{5, 0, 3, 2}, 16, 16, 0, 0},
+		{FileVersion{5, 0, 3, 2}, 18, 16, 17, 18},
+	}
+	for _, test := range tests {
+		var m IDMappings
+		err := m.deserialize(idMappingsData(test.n), test.ver)
+		if err != nil {
+			t.Errorf("%v with %d counts: %v", test.ver, test.n, err)
+			continue
+		}
+		if m.Style != 15 || m.MemoShape != test.memo ||
+			m.TrackChanges != test.changes || m.TrackChangeAuthor != test.by {
+			t.Errorf("%v with %d counts: got %+v", test.ver, test.n, m)
+		}
+	}
+
+	var m IDMappings
+	err := m.deserialize(idMappingsData(14), FileVersion{5, 0, 3, 2})
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
+
+// TestIDMappingsCheck checks that a missing record is reported.
+//
+// TestIDMappingsCheck는 빠진 레코드를 알려주는지 확인합니다.
+func TestIDMappingsCheck(t *testing.T) {
+	m := IDMappings{KoreanFont: 1, EnglishFont: 1, Style: 1}
+	records := []*Record{
+		{TagID: TagFaceName}, {TagID: TagFaceName}, {TagID: TagStyle},
+	}
+	if err := m.check(records); err != nil {
+		t.Fatal(err)
+	}
+
+	err := m.check(records[1:])
+	if !errors.Is(err, ErrRecordCount) {
+		t.Fatalf("expected ErrRecordCount, got %v", err)
+	}
+	want := "record count doesn't match ID_MAPPINGS 레코드 개수가 " +
+		"ID_MAPPINGS와 다릅니다: HWPTAG_FACE_NAME: want 2, got 1"
+	if err.Error() != want {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
+// TestIDMappingsNegativeFonts checks that a negative font count is
+// rejected even when the counts add up to the FACE_NAME records.
+//
+// TestIDMappingsNegativeFonts는 글꼴 개수의 합이 FACE_NAME 레코드 개수와
+// 같아도 음수인 글꼴 개수를 거부하는지 확인합니다.
+func TestIDMappingsNegativeFonts(t *testing.T) {
+	m := IDMappings{KoreanFont: 3, EnglishFont: -1}
+	records := []*Record{{TagID: TagFaceName}, {TagID: TagFaceName}}
+
+	err := m.check(records)
+	if !errors.Is(err, ErrRecordCount) {
+		t.Fatalf("expected ErrRecordCount, got %v", err)
+	}
+
+	// Without the check the fonts are still grouped without a panic.
+	di := DocInfo{IDMappings: m}
+	di.groupFaceNames([]*FaceName{{}, {}})
+	if len(di.FaceName[FontHangul]) != 2 || len(di.FaceName[FontLatin]) != 0 {
+		t.Errorf("unexpected groups %d %d", len(di.FaceName[FontHangul]),
+			len(di.FaceName[FontLatin]))
+	}
+}
+
+// TestFaceNames checks the fonts of the testdata file.
+//
+// TestFaceNames는 testdata 파일의 글꼴을 확인합니다.
+func TestFaceNames(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for lang := FontHangul; lang <= FontUser; lang++ {
+		fonts := hwp.DocInfo.FaceName[lang]
+		if len(fonts) != 2 {
+			t.Fatalf("%v: expected 2 fonts, got %d", lang, len(fonts))
+		}
+		if fonts[0].FName != "함초롬돋움" || fonts[1].FName != "함초롬바탕" {
+			t.Errorf("%v: unexpected fonts %q %q", lang,
+				fonts[0].FName, fonts[1].FName)
+		}
+	}
+
+	fn, ok := hwp.DocInfo.Font(FontLatin, 1)
+	if !ok {
+		t.Fatal("latin font 1 missing")
+	}
+	if fn.HasSubstitute() || !fn.HasFaceType() || !fn.HasDefault() {
+		t.Errorf("unexpected property %#x", fn.Property)
+	}
+	if fn.DefaultFName != "HCR Batang" {
+		t.Errorf("unexpected default font %q", fn.DefaultFName)
+	}
+	want := Panose{FamilyType: 2, SerifStyle: 3, Weight: 6, Proportion: 4,
+		StrokeVariation: 1, ArmStyle: 1, Letterform: 1, Midline: 1, XHeight: 1}
+	if fn.FaceType != want {
+		t.Errorf("expected %+v, got %+v", want, fn.FaceType)
+	}
+
+	if _, ok := hwp.DocInfo.Font(FontLatin, 2); ok {
+		t.Error("expected no latin font 2")
+	}
+	if _, ok := hwp.DocInfo.Font(FontLanguage(7), 0); ok {
+		t.Error("expected no font for an unknown language")
+	}
+}
+
+// TestFaceNameSubstitute decodes a font with only a substitute font.
+//
+// TestFaceNameSubstitute는 대체 글꼴만 있는 글꼴을 읽습니다.
+func TestFaceNameSubstitute(t *testing.T) {
+	data := []byte{
+		0x80,
+		1, 0, 'A', 0,
+		byte(SubstituteTTF),
+		2, 0, 'B', 0, 'C', 0,
+	}
+
+	var fn FaceName
+	err := fn.deserialize(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fn.FName != "A" || fn.SubFType != SubstituteTTF || fn.SubFName != "BC" {
+		t.Errorf("unexpected font %+v", fn)
+	}
+	if fn.SubFType.String() != "TTF" {
+		t.Errorf("unexpected substitute type %v", fn.SubFType)
+	}
+
+	err = fn.deserialize(data[:len(data)-1])
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
@@ -2,8 +2,14 @@ package hwp50
 
 import (
 	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+
+	"github.com/richardlehane/mscfb"
 )
 
 // Signature is the first 32 bytes that the hwp50 file format is going
@@ -81,49 +87,65 @@ type FileHeader struct {
 
 	//################################################
 	//################################################
-	// Bytes 207-256 are reserved.
-	// 207-256바이트는 아직 사용하지 않습니다.
+	// Bytes 49-256 are reserved.
+	// 49-256바이트는 아직 사용하지 않습니다.
 	//################################################
 	//################################################
 }
 
-// GrabFileHeader grabs the FileHeader from the given hwp file
-// Needed as the OLE parser seems to just skip over the FileHeader
-// TODO maybe this can be fixed
-func (fh *FileHeader) GrabFileHeader(f io.ReadSeeker) error {
-	var compare [32]byte
-	var offset int
-
-	// Bit of a hack. Seeks for the hwp header as .hwp file uses the OLE
-	// file structure. Records the offset
-	for {
-		// read 32 bytes to the compare var
-		// 파일에서 32바이트씩 읽기
-		// i returns how many bytes read
-		// i는 얼마나 읽혔는지를 나타냅니다
-		i, err := f.Read(compare[:])
+// Errors returned while reading the FileHeader stream.
+//
+// FileHeader 스트림을 읽을 때 반환되는 에러들입니다.
+var (
+	// ErrMissingFileHeader is returned when the compound file has no
+	// "FileHeader" stream.
+	//
+	// ErrMissingFileHeader는 복합 파일에 "FileHeader" 스트림이 없을 때
+	// 반환됩니다.
+	ErrMissingFileHeader = errors.New("missing FileHeader stream " +
+		"FileHeader 스트림이 없습니다")
+
+	// ErrShortFileHeader is returned when the FileHeader stream is
+	// shorter than 256 bytes.
+	//
+	// ErrShortFileHeader는 FileHeader 스트림이 256 바이트보다 짧을 때
+	// 반환됩니다.
+	ErrShortFileHeader = errors.New("FileHeader stream is shorter than " +
+		"256 bytes FileHeader 스트림이 256 바이트보다 짧습니다")
+
+	// ErrBadSignature is returned when the FileHeader doesn't start with
+	// Signature.
+	//
+	// ErrBadSignature는 FileHeader가 Signature로 시작하지 않을 때
+	// 반환됩니다.
+	ErrBadSignature = errors.New("file corrupted or not a hwp file " +
+		"이 파일은 손상되었거나 hwp 파일이 아닙니다")
+)
+
+// fileHeaderSize is the size of the FileHeader stream.
+const fileHeaderSize = 256
+
+// GrabFileHeader grabs the FileHeader from the "FileHeader" stream of the
+// given hwp compound file.
+//
+// GrabFileHeader는 주어진 hwp 복합 파일의 "FileHeader" 스트림에서
+// FileHeader를 읽습니다.
+func (fh *FileHeader) GrabFileHeader(ra io.ReaderAt) error {
+	doc, err := mscfb.New(ra)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNotCompoundFile, err)
+	}
+
+	for entry, err := doc.Next(); err != io.EOF; entry, err = doc.Next() {
 		if err != nil {
 			return err
 		}
-		// If Signature found, then break
-		// Signature 찾으면 break
-		if compare == Signature {
-			break
+		if len(entry.Path) == 0 && entry.Name == streamFileHeader {
+			return fh.DeserializeFileHeader(entry)
 		}
-		// add onto the offset last
-		// 마지막으로 offset에 값 추가하기
-		offset += i
 	}
 
-	// Seek back to the start of the hwp file header
-	// hwp Signature를 찾은 곳으로 seek
-	f.Seek(int64(offset), 0)
-
-	err := fh.DeserializeFileHeader(f)
-	if err != nil {
-		return err
-	}
-	return nil
+	return ErrMissingFileHeader
 }
 
 // DeserializeFileHeader reads the file header information from the hwp50 file
@@ -134,9 +156,12 @@ func (fh *FileHeader) GrabFileHeader(f io.ReadSeeker) error {
 func (f *FileHeader) DeserializeFileHeader(r io.Reader) (err error) {
 	// raw represents the hwp file's 256 byte header
 	// raw는 256byte의 hwp 파일 헤더를 뜻합니다.
-	raw := make([]byte, 256)
+	raw := make([]byte, fileHeaderSize)
 
-	_, err = r.Read(raw)
+	_, err = io.ReadFull(r, raw)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrShortFileHeader
+	}
 	if err != nil {
 		return err
 	}
@@ -148,19 +173,18 @@ func (f *FileHeader) DeserializeFileHeader(r io.Reader) (err error) {
 	copy(check[:], raw[:32])
 
 	if check != Signature {
-		return fmt.Errorf("file corrupted or not a hwp file " +
-			"이 파일은 손상되었거나 hwp 파일이 아닙니다")
+		return ErrBadSignature
 	}
 
 	// Set FileHeader Sig field
 	// FileHeader Sig 필드 init
-	copy(f.Sig[:], raw[:31])
+	f.Sig = check
 
 	// Set FileHeader Version field
 	// FileHeader Version 필드 init
 	var versionBytes [4]byte
 
-	copy(versionBytes[:], raw[31:31+4])
+	copy(versionBytes[:], raw[32:32+4])
 
 	// Set Version struct with 4 bytes of version (little endian)
 	// 버전 struct
@@ -169,30 +193,19 @@ func (f *FileHeader) DeserializeFileHeader(r io.Reader) (err error) {
 		return err
 	}
 
-	fp := binary.LittleEndian.Uint32(raw[35 : 35+4])
+	fp := binary.LittleEndian.Uint32(raw[36 : 36+4])
 	f.Fp = FirstProperty(fp) // typecast
 
-	sp := binary.LittleEndian.Uint32(raw[39 : 39+4])
+	sp := binary.LittleEndian.Uint32(raw[40 : 40+4])
 	f.Sp = SecondProperty(sp) // typecast
 
 	f.EncryptVersion = binary.LittleEndian.Uint32(raw[44 : 44+4])
 
-	f.KOGLCountry = raw[49]
+	f.KOGLCountry = raw[48]
 
-	var (
-		reserved [207]byte
-		empty    [207]byte
-	)
-
-	copy(reserved[:], raw[50:])
-
-	if reserved != empty {
-		fmt.Println("WARNING:File corrupted or " +
-			"new version of hwp available" +
-			"주의: 파일이 손상되었거나 일부 지원하지 않는 새로운" +
-			"버전의 hwp 파일입니다.")
-		fmt.Printf("%x\n", reserved)
-	}
+	// Bytes 49-256 are reserved and ignored. Newer versions of hwp may
+	// use them but the fields we know of are still valid.
+	// 49-256 바이트는 사용하지 않으므로 무시합니다.
 
 	return nil
 }
@@ -215,12 +228,59 @@ func (f *FileHeader) DeserializeFileHeader(r io.Reader) (err error) {
 // FirstProperty는 hwp50 파일이 사용하는 4바이트 bitflag를 뜻합니다.
 type FirstProperty uint32
 
+// Flags of FirstProperty. Each is the bit its method below checks.
+//
+// FirstProperty의 플래그들입니다. 각각 밑의 method가 확인하는 비트입니다.
+const (
+	FpCompressed FirstProperty = 1 << iota
+	FpEncrypted
+	FpExported
+	FpScript
+	FpDRM
+	FpXMLTemplateStorage
+	FpFileHistory
+	FpDigitalSig
+	FpEncryptedWithKISAKey
+	FpSpareDigitalSig
+	FpKISADRM
+	FpCCL
+	FpMobileOptimized
+	FpPrivateInfoProtected
+	FpModificationTracked
+	FpKOGLLicense
+	FpVideoControls
+	FpChapterControlField
+)
+
+// firstPropertyNames are the names Flags uses for the bits of
+// FirstProperty, in bit order.
+var firstPropertyNames = []string{
+	"compressed",
+	"encrypted",
+	"distribution",
+	"script",
+	"drm",
+	"xmlTemplate",
+	"history",
+	"digitalSignature",
+	"kisaEncrypted",
+	"spareDigitalSignature",
+	"kisaDRM",
+	"ccl",
+	"mobileOptimized",
+	"privateInfoProtected",
+	"trackChanges",
+	"kogl",
+	"videoControl",
+	"chapterControlField",
+}
+
 // IsCompressed is the 0th of FirstProperty that denotes if the file
 // is compressed
 //
 // IsCompressed는 파일이 압축되었는지를 나타내는 FirstProperty의 0번째 비트입니다
 func (fp FirstProperty) IsCompressed() bool {
-	return fp&(1<<0) == 1
+	return fp&FpCompressed != 0
 }
 
 // IsEncrypted is the 1st bit of FirstProperty that denotes if the
@@ -229,7 +289,7 @@ func (fp FirstProperty) IsCompressed() bool {
 // IsEncrypted는 파일이 암호화 되었는지를 나타내는 FirstProperty의
 // 1번째 비트입니다
 func (fp FirstProperty) IsEncrypted() bool {
-	return fp&(1<<1) == 1
+	return fp&FpEncrypted != 0
 }
 
 // IsExported is the 2nd bit that denotes if the file is a file for distribution
@@ -239,21 +299,21 @@ func (fp FirstProperty) IsEncrypted() bool {
 // 비트입니다.
 // distribution/ 다이렉토리를 참고하시기 바랍니다.
 func (fp FirstProperty) IsExported() bool {
-	return fp&(1<<2) == 1
+	return fp&FpExported != 0
 }
 
 // HasScript is the 3rd bit that denotes if the file has scripts
 //
 // HasScript는 파일이 스크립트를 저장하는지를 나타내는 3번째 비트입니다
 func (fp FirstProperty) HasScript() bool {
-	return fp&(1<<3) == 1
+	return fp&FpScript != 0
 }
 
 // HasDRM is the 4th bit that denotes if the file is DRMed. Ew
 //
 // HasDRM은 파일이 DRM 걸려있는지를 나타내는 4번째 비트입니다.
 func (fp FirstProperty) HasDRM() bool {
-	return fp&(1<<4) == 1
+	return fp&FpDRM != 0
 }
 
 // HasXMLTemplateStorage is the 5th bit that denotes if the file has
@@ -262,21 +322,21 @@ func (fp FirstProperty) HasDRM() bool {
 // HasXMLTemplateStorage는 파일이 XMLTemplate storage가 있는지를
 // 나타내는 5번째 비트입니다.
 func (fp FirstProperty) HasXMLTemplateStorage() bool {
-	return fp&(1<<5) == 1
+	return fp&FpXMLTemplateStorage != 0
 }
 
 // HasFileHistory is the 6th bit that denotes if the file history is included
 //
 // HasFileHistory는 파일이 이력을 저장했는지를 나타내는 6번째 비트입니다.
 func (fp FirstProperty) HasFileHistory() bool {
-	return fp&(1<<6) == 1
+	return fp&FpFileHistory != 0
 }
 
 // HasDigitalSig is the 7th bit that denotes if the file has a digital signature
 //
 // HasDigitalSig는 전자 서명 정보가 있는지를 나타내는 7번째 비트입니다.
 func (fp FirstProperty) HasDigitalSig() bool {
-	return fp&(1<<7) == 1
+	return fp&FpDigitalSig != 0
 }
 
 // IsEncryptedWithKISAKey is the 8th bit that  denotes if the file is
@@ -288,7 +348,7 @@ func (fp FirstProperty) HasDigitalSig() bool {
 // IsEncryptedWithKISAKey는 공인인증서로 암호화 되었는지를 뜻하는 8번째
 // 비트입니다.
 func (fp FirstProperty) IsEncryptedWithKISAKey() bool {
-	return fp&(1<<8) == 1
+	return fp&FpEncryptedWithKISAKey != 0
 }
 
 // HasSpareDigitalSig is the 9th bit that denotes if the file has a spare
@@ -298,7 +358,7 @@ func (fp FirstProperty) IsEncryptedWithKISAKey() bool {
 // 비트입니다.
 // 저도 뭔말인지 몰라요.
 func (fp FirstProperty) HasSpareDigitalSig() bool {
-	return fp&(1<<9) == 1
+	return fp&FpSpareDigitalSig != 0
 }
 
 // HasKISADRM is the 10th bit that denotes if the file has a DRM with the
@@ -306,7 +366,7 @@ func (fp FirstProperty) HasSpareDigitalSig() bool {
 //
 // HasKISADRM은 공인인증서로 DRM 되었는지를 뜻하는 10번째 비트입니다.
 func (fp FirstProperty) HasKISADRM() bool {
-	return fp&(1<<10) == 1
+	return fp&FpKISADRM != 0
 }
 
 // HasCCL is the 11th bit that denotes if the file has a CCL
@@ -315,14 +375,14 @@ func (fp FirstProperty) HasKISADRM() bool {
 // HasCCL은 파일이 CCL(Creative Commons License)가 있는지를 뜻하는 11번째
 // 비트입니다.
 func (fp FirstProperty) HasCCL() bool {
-	return fp&(1<<11) == 1
+	return fp&FpCCL != 0
 }
 
 // IsMobileOptimized is the 12th bit that denotes if the file is mobile optimized
 //
 // IsMobileOptimized는 모바일 최적화가 되었는지를 뜻하는 13번째 비트입니다.
 func (fp FirstProperty) IsMobileOptimized() bool {
-	return fp&(1<<12) == 1
+	return fp&FpMobileOptimized != 0
 }
 
 // IsPrivateInfoProtected is the 13th bit that denotes if the file is a
@@ -332,7 +392,7 @@ func (fp FirstProperty) IsMobileOptimized() bool {
 // 비트입니다.
 // 네. 저도 뭔말인지 몰라요.
 func (fp FirstProperty) IsPrivateInfoProtected() bool {
-	return fp&(1<<13) == 1
+	return fp&FpPrivateInfoProtected != 0
 }
 
 // IsModificationTracked is the 14th bit that denotes if the file tracks
@@ -340,7 +400,7 @@ func (fp FirstProperty) IsPrivateInfoProtected() bool {
 //
 // IsModificationTracked은 파일이 변경 추적을 하는지를 뜻하는 14번째 비트입니다
 func (fp FirstProperty) IsModificationTracked() bool {
-	return fp&(1<<14) == 1
+	return fp&FpModificationTracked != 0
 }
 
 // HasKOGLLicense is the 15th bit that denotes if the file has a KOGL license.
@@ -355,14 +415,14 @@ func (fp FirstProperty) IsModificationTracked() bool {
 // HasKOGLLicense는 KOGL 공공누리 저작권 문서가 있는지를 뜻하는 15번째
 // 비트입니다. kogl.co.kr에서 한글로 라이센스에 대해서 보실 수 있습니다.
 func (fp FirstProperty) HasKOGLLicense() bool {
-	return fp&(1<<15) == 1
+	return fp&FpKOGLLicense != 0
 }
 
 // HasVideoControls is the 16th bit that denotes if the file has video controls.
 //
 // HasVideoControls는 파일이 비디오 컨트롤이 있는지를 뜻하는 16번째 비트입니다.
 func (fp FirstProperty) HasVideoControls() bool {
-	return fp&(1<<16) == 1
+	return fp&FpVideoControls != 0
 }
 
 // HasChapterControlField is the 17th bit that denotes if the file has
@@ -371,7 +431,54 @@ func (fp FirstProperty) HasVideoControls() bool {
 // HasChapterControlField는 차례 필드 컬트롤이 있는지를 뜻하는 17번째
 // 비트입니다.
 func (fp FirstProperty) HasChapterControlField() bool {
-	return fp&(1<<17) == 1
+	return fp&FpChapterControlField != 0
+}
+
+// Has reports if every bit of flags is set in fp.
+//
+// Has는 flags의 모든 비트가 fp에 켜져 있는지를 뜻합니다.
+func (fp FirstProperty) Has(flags FirstProperty) bool {
+	return fp&flags == flags
+}
+
+// Set turns on the bits of flags.
+//
+// Set은 flags의 비트들을 켭니다.
+func (fp *FirstProperty) Set(flags FirstProperty) {
+	*fp |= flags
+}
+
+// Clear turns off the bits of flags.
+//
+// Clear는 flags의 비트들을 끕니다.
+func (fp *FirstProperty) Clear(flags FirstProperty) {
+	*fp &^= flags
+}
+
+// Flags returns the names of the bits set in fp in bit order. Reserved bits
+// that are set are named "bitN".
+//
+// Flags는 fp에 켜진 비트들의 이름을 비트 순서대로 반환합니다. 켜진 예약
+// 비트는 "bitN"으로 나옵니다.
+func (fp FirstProperty) Flags() []string {
+	return flagNames(uint32(fp), firstPropertyNames)
+}
+
+// String returns the names of the bits set in fp joined by "|".
+//
+// String은 fp에 켜진 비트들의 이름을 "|"로 이어서 반환합니다.
+func (fp FirstProperty) String() string {
+	return strings.Join(fp.Flags(), "|")
+}
+
+// MarshalText implements encoding.TextMarshaler with String.
+func (fp FirstProperty) MarshalText() ([]byte, error) {
+	return []byte(fp.String()), nil
+}
+
+// MarshalJSON implements json.Marshaler as the list of Flags.
+func (fp FirstProperty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fp.Flags())
 }
 
 /*
@@ -392,18 +499,35 @@ func (fp FirstProperty) HasChapterControlField() bool {
 // SecondProperty는 hwp50 파일이 사용하는 4바이트 bitflag를 뜻합니다.
 type SecondProperty uint32
 
+// Flags of SecondProperty. Each is the bit its method below checks.
+//
+// SecondProperty의 플래그들입니다. 각각 밑의 method가 확인하는 비트입니다.
+const (
+	SpLicenseInfo SecondProperty = 1 << iota
+	SpCopyProtected
+	SpCopyWithoutModification
+)
+
+// secondPropertyNames are the names Flags uses for the bits of
+// SecondProperty, in bit order.
+var secondPropertyNames = []string{
+	"licenseInfo",
+	"copyProtected",
+	"copyWithoutModification",
+}
+
 // HasLicenseInfo denotes if the file has a CCL or KOGL license.
 //
 // HasLicenseInfo는 파일이 CCL 또는 KOGL 라이센스가 있는지를 뜻합니다.
 func (sp SecondProperty) HasLicenseInfo() bool {
-	return sp&(1<<0) == 1
+	return sp&SpLicenseInfo != 0
 }
 
 // IsCopyProtected denotes if the file cannot be copied.
 //
 // IsCopyProtected는 파일이 복제 제한 되어있는지를 뜻합니다.
 func (sp SecondProperty) IsCopyProtected() bool {
-	return sp&(1<<1) == 1
+	return sp&SpCopyProtected != 0
 }
 
 // IsAllowedToCopyWithoutModification denotes if the file can be copied
@@ -412,7 +536,71 @@ func (sp SecondProperty) IsCopyProtected() bool {
 // IsAllowedToCopyWithoutModification는 동일 조건 하에 복제가
 // 허용되는지를 뜻합니다.
 func (sp SecondProperty) IsAllowedToCopyWithoutModification() bool {
-	return sp&(1<<2) == 1
+	return sp&SpCopyWithoutModification != 0
+}
+
+// Has reports if every bit of flags is set in sp.
+//
+// Has는 flags의 모든 비트가 sp에 켜져 있는지를 뜻합니다.
+func (sp SecondProperty) Has(flags SecondProperty) bool {
+	return sp&flags == flags
+}
+
+// Set turns on the bits of flags.
+//
+// Set은 flags의 비트들을 켭니다.
+func (sp *SecondProperty) Set(flags SecondProperty) {
+	*sp |= flags
+}
+
+// Clear turns off the bits of flags.
+//
+// Clear는 flags의 비트들을 끕니다.
+func (sp *SecondProperty) Clear(flags SecondProperty) {
+	*sp &^= flags
+}
+
+// Flags returns the names of the bits set in sp in bit order. Reserved bits
+// that are set are named "bitN".
+//
+// Flags는 sp에 켜진 비트들의 이름을 비트 순서대로 반환합니다. 켜진 예약
+// 비트는 "bitN"으로 나옵니다.
+func (sp SecondProperty) Flags() []string {
+	return flagNames(uint32(sp), secondPropertyNames)
+}
+
+// String returns the names of the bits set in sp joined by "|".
+//
+// String은 sp에 켜진 비트들의 이름을 "|"로 이어서 반환합니다.
+func (sp SecondProperty) String() string {
+	return strings.Join(sp.Flags(), "|")
+}
+
+// MarshalText implements encoding.TextMarshaler with String.
+func (sp SecondProperty) MarshalText() ([]byte, error) {
+	return []byte(sp.String()), nil
+}
+
+// MarshalJSON implements json.Marshaler as the list of Flags.
+func (sp SecondProperty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sp.Flags())
+}
+
+// flagNames returns the names of the bits set in v. Bits past the end of
+// names are named "bitN".
+func flagNames(v uint32, names []string) []string {
+	flags := []string{}
+	for i := 0; i < 32; i++ {
+		if v&(1<<i) == 0 {
+			continue
+		}
+		if i < len(names) {
+			flags = append(flags, names[i])
+		} else {
+			flags = append(flags, fmt.Sprintf("bit%d", i))
+		}
+	}
+	return flags
 }
 
 // FileVersion is a 4 byte representation of the hwp50 file versioning
@@ -449,10 +637,94 @@ type FileVersion struct {
 // deserializeVersion은 4 바이트 array의 버전 정보를 deserialize 합니다.
 // Argument b는 little endian 포맷으로 주어야 합니다.
 func (fv *FileVersion) deserializeVersion(b [4]byte) error {
-	fv.Major = b[2]
-	fv.Minor = b[3]
-	fv.Micro = b[0]
-	fv.Extra = b[1]
+	// The version is a DWORD of 0xMMnnPPrr so the most significant
+	// byte comes last.
+	// 버전은 0xMMnnPPrr 형식의 DWORD라 가장 큰 바이트가 마지막에 옵니다.
+	fv.Major = b[3]
+	fv.Minor = b[2]
+	fv.Micro = b[1]
+	fv.Extra = b[0]
 
 	return nil
 }
+
+// SupportedVersion is the version of the format this package implements.
+// Files are readable as long as their Major and Minor match it.
+//
+// SupportedVersion은 이 패키지가 구현한 포맷의 버전입니다. Major와 Minor가
+// 같으면 읽을 수 있습니다.
+var SupportedVersion = FileVersion{Major: 5, Minor: 0, Micro: 0, Extra: 0}
+
+// ErrIncompatibleVersion is returned for files whose Major or Minor version
+// differ from SupportedVersion.
+//
+// ErrIncompatibleVersion은 Major나 Minor 버전이 SupportedVersion과 다른
+// 파일에 대해 반환됩니다.
+var ErrIncompatibleVersion = errors.New("incompatible file version " +
+	"호환되지 않는 파일 버전입니다")
+
+// ParseFileVersion parses a version written as "5.0.3.4".
+//
+// ParseFileVersion은 "5.0.3.4" 형식의 버전을 읽습니다.
+func ParseFileVersion(s string) (FileVersion, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 4 {
+		return FileVersion{}, fmt.Errorf("bad file version %q: "+
+			"expected 4 numbers separated by dots", s)
+	}
+
+	var b [4]uint8
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 8)
+		if err != nil {
+			return FileVersion{}, fmt.Errorf("bad file version %q: %w", s, err)
+		}
+		b[i] = uint8(n)
+	}
+
+	return FileVersion{Major: b[0], Minor: b[1], Micro: b[2], Extra: b[3]}, nil
+}
+
+// String returns the version as "5.0.3.4".
+//
+// String은 버전을 "5.0.3.4" 형식으로 반환합니다.
+func (fv FileVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", fv.Major, fv.Minor, fv.Micro, fv.Extra)
+}
+
+// Compare returns -1, 0 or 1 when fv is older than, the same as or newer
+// than other.
+//
+// Compare는 fv가 other보다 오래되었으면 -1, 같으면 0, 최신이면 1을
+// 반환합니다.
+func (fv FileVersion) Compare(other FileVersion) int {
+	a := [4]uint8{fv.Major, fv.Minor, fv.Micro, fv.Extra}
+	b := [4]uint8{other.Major, other.Minor, other.Micro, other.Extra}
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports if fv is the given version or newer. Decoders use it for
+// fields that only exist from a version on.
+//
+// AtLeast는 fv가 주어진 버전과 같거나 더 최신인지를 뜻합니다. 특정 버전부터
+// 있는 필드를 decode 할 때 씁니다.
+func (fv FileVersion) AtLeast(major, minor, micro, extra uint8) bool {
+	return fv.Compare(FileVersion{major, minor, micro, extra}) >= 0
+}
+
+// IsCompatible reports if fv can be read by an implementation of other.
+// Major and Minor must match; Micro and Extra only add to the format.
+//
+// IsCompatible은 other를 구현한 코드로 fv를 읽을 수 있는지를 뜻합니다.
+// Major와 Minor가 같아야 하고, Micro와 Extra는 추가만 합니다.
+func (fv FileVersion) IsCompatible(other FileVersion) bool {
+	return fv.Major == other.Major && fv.Minor == other.Minor
+}
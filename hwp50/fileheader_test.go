@@ -1,89 +1,287 @@
 package hwp50
 
 import (
-	"fmt"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"os"
 	"testing"
 )
 
-// TestDeserializeFileHeader seeks to the hwp header from a .hwp file and
-// reads it.
-// TestDeserializeFileHeader는 .hwp 파일 헤더를 파일에서 찾아서 읽습니다.
+// TestDeserializeFileHeader reads the hwp header from the FileHeader stream
+// of a .hwp file.
+// TestDeserializeFileHeader는 .hwp 파일의 FileHeader 스트림에서 헤더를
+// 읽습니다.
 func TestDeserializeFileHeader(t *testing.T) {
 	f, err := os.Open("testdata")
 	if err != nil {
 		t.Fatal(err)
 	}
+	defer f.Close()
 
-	var compare [32]byte
-	var offset int
-
-	// Bit of a hack. Seeks for the hwp header as .hwp file uses the OLE
-	// file structure. Records the offset
-	// OLE 파일 구조를 무시하고 hwp파일 Signature만 찾아서 offset을
-	// 기록합니다.
-	for {
-		// read 32 bytes to the compare var
-		// 파일에서 32바이트씩 읽기
-		// i returns how many bytes read
-		// i는 얼마나 읽혔는지를 나타냅니다
-		i, err := f.Read(compare[:])
-		if err != nil {
-			t.Fatal(err)
+	// init header
+	// 헤더 init
+	var h FileHeader
+	err = h.GrabFileHeader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := FileVersion{Major: 5, Minor: 0, Micro: 4, Extra: 0}
+	if h.Version != want {
+		t.Errorf("expected version %v, got %v", want, h.Version)
+	}
+	if h.EncryptVersion != 4 {
+		t.Errorf("expected EncryptVersion 4, got %d", h.EncryptVersion)
+	}
+
+	if h.KOGLCountry != 0 {
+		t.Errorf("expected KOGLCountry 0, got %d", h.KOGLCountry)
+	}
+
+	// The fixture is only compressed.
+	// fixture는 압축만 되어 있습니다.
+	for _, test := range firstPropertyTests {
+		if got := test.method(h.Fp); got != (test.flag == FpCompressed) {
+			t.Errorf("%s: got %v", test.name, got)
 		}
-		// If Signature found, then break
-		// Signature 찾으면 break
-		if compare == Signature {
-			break
+	}
+	for _, test := range secondPropertyTests {
+		if test.method(h.Sp) {
+			t.Errorf("%s: got true", test.name)
 		}
-		// add onto the offset last
-		// 마지막으로 offset에 값 추가하기
-		offset += i
 	}
+}
+
+// firstPropertyTests pairs every flag of FirstProperty with its method.
+var firstPropertyTests = []struct {
+	name   string
+	flag   FirstProperty
+	method func(FirstProperty) bool
+}{
+	{"compressed", FpCompressed, FirstProperty.IsCompressed},
+	{"encrypted", FpEncrypted, FirstProperty.IsEncrypted},
+	{"distribution", FpExported, FirstProperty.IsExported},
+	{"script", FpScript, FirstProperty.HasScript},
+	{"drm", FpDRM, FirstProperty.HasDRM},
+	{"xmlTemplate", FpXMLTemplateStorage, FirstProperty.HasXMLTemplateStorage},
+	{"history", FpFileHistory, FirstProperty.HasFileHistory},
+	{"digitalSignature", FpDigitalSig, FirstProperty.HasDigitalSig},
+	{"kisaEncrypted", FpEncryptedWithKISAKey, FirstProperty.IsEncryptedWithKISAKey},
+	{"spareDigitalSignature", FpSpareDigitalSig, FirstProperty.HasSpareDigitalSig},
+	{"kisaDRM", FpKISADRM, FirstProperty.HasKISADRM},
+	{"ccl", FpCCL, FirstProperty.HasCCL},
+	{"mobileOptimized", FpMobileOptimized, FirstProperty.IsMobileOptimized},
+	{"privateInfoProtected", FpPrivateInfoProtected, FirstProperty.IsPrivateInfoProtected},
+	{"trackChanges", FpModificationTracked, FirstProperty.IsModificationTracked},
+	{"kogl", FpKOGLLicense, FirstProperty.HasKOGLLicense},
+	{"videoControl", FpVideoControls, FirstProperty.HasVideoControls},
+	{"chapterControlField", FpChapterControlField, FirstProperty.HasChapterControlField},
+}
 
-	// Seek back to the start of the hwp file header
-	// hwp Signature를 찾은 곳으로 seek
-	f.Seek(int64(offset), 0)
+// secondPropertyTests pairs every flag of SecondProperty with its method.
+var secondPropertyTests = []struct {
+	name   string
+	flag   SecondProperty
+	method func(SecondProperty) bool
+}{
+	{"licenseInfo", SpLicenseInfo, SecondProperty.HasLicenseInfo},
+	{"copyProtected", SpCopyProtected, SecondProperty.IsCopyProtected},
+	{"copyWithoutModification", SpCopyWithoutModification,
+		SecondProperty.IsAllowedToCopyWithoutModification},
+}
 
-	// init header
-	// 헤더 init
+// TestFirstProperty sets one bit at a time and checks that only the
+// matching method and name report it.
+// TestFirstProperty는 비트를 하나씩 켜고 해당하는 method와 이름만 그
+// 비트를 알리는지 확인합니다.
+func TestFirstProperty(t *testing.T) {
+	for i, test := range firstPropertyTests {
+		if test.flag != 1<<i {
+			t.Fatalf("%s: expected bit %d", test.name, i)
+		}
+
+		var fp FirstProperty
+		fp.Set(test.flag)
+		for _, other := range firstPropertyTests {
+			if got := other.method(fp); got != (other.flag == test.flag) {
+				t.Errorf("%s set: %s got %v", test.name, other.name, got)
+			}
+		}
+
+		if flags := fp.Flags(); len(flags) != 1 || flags[0] != test.name {
+			t.Errorf("%s set: unexpected flags %v", test.name, flags)
+		}
+
+		fp.Clear(test.flag)
+		if fp != 0 || test.method(fp) {
+			t.Errorf("%s cleared: got %v", test.name, fp)
+		}
+	}
+}
+
+// TestSecondProperty sets one bit at a time and checks that only the
+// matching method and name report it.
+// TestSecondProperty는 비트를 하나씩 켜고 해당하는 method와 이름만 그
+// 비트를 알리는지 확인합니다.
+func TestSecondProperty(t *testing.T) {
+	for i, test := range secondPropertyTests {
+		if test.flag != 1<<i {
+			t.Fatalf("%s: expected bit %d", test.name, i)
+		}
+
+		var sp SecondProperty
+		sp.Set(test.flag)
+		for _, other := range secondPropertyTests {
+			if got := other.method(sp); got != (other.flag == test.flag) {
+				t.Errorf("%s set: %s got %v", test.name, other.name, got)
+			}
+		}
+
+		if flags := sp.Flags(); len(flags) != 1 || flags[0] != test.name {
+			t.Errorf("%s set: unexpected flags %v", test.name, flags)
+		}
+
+		sp.Clear(test.flag)
+		if sp != 0 || test.method(sp) {
+			t.Errorf("%s cleared: got %v", test.name, sp)
+		}
+	}
+}
+
+// TestPropertyMarshal checks the text and JSON forms of the flags.
+// TestPropertyMarshal은 플래그의 텍스트와 JSON 형식을 확인합니다.
+func TestPropertyMarshal(t *testing.T) {
+	fp := FpCompressed | FpDRM | 1<<20
+	if fp.String() != "compressed|drm|bit20" {
+		t.Errorf("unexpected string %q", fp.String())
+	}
+	if !fp.Has(FpCompressed|FpDRM) || fp.Has(FpCompressed|FpCCL) {
+		t.Errorf("unexpected Has for %v", fp)
+	}
+
+	b, err := json.Marshal(struct {
+		Fp FirstProperty
+		Sp SecondProperty
+		M  map[string]SecondProperty
+	}{fp, SpCopyProtected, map[string]SecondProperty{"a": 0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Fp":["compressed","drm","bit20"],"Sp":["copyProtected"],"M":{"a":[]}}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+
+	text, err := SpCopyProtected.MarshalText()
+	if err != nil || string(text) != "copyProtected" {
+		t.Errorf("unexpected text %q %v", text, err)
+	}
+}
+
+// TestDeserializeShortFileHeader checks that a truncated header is reported
+// with ErrShortFileHeader.
+// TestDeserializeShortFileHeader는 잘린 헤더가 ErrShortFileHeader를
+// 반환하는지 확인합니다.
+func TestDeserializeShortFileHeader(t *testing.T) {
 	var h FileHeader
-	err = h.DeserializeFileHeader(f)
+	err := h.DeserializeFileHeader(bytes.NewReader(Signature[:]))
+	if !errors.Is(err, ErrShortFileHeader) {
+		t.Fatalf("expected ErrShortFileHeader, got %v", err)
+	}
+
+	err = h.DeserializeFileHeader(bytes.NewReader(make([]byte, 256)))
+	if !errors.Is(err, ErrBadSignature) {
+		t.Fatalf("expected ErrBadSignature, got %v", err)
+	}
+}
+
+// TestSummary checks the summary of a header with a KOGL license.
+// TestSummary는 KOGL 라이센스가 있는 헤더의 요약을 확인합니다.
+func TestSummary(t *testing.T) {
+	h := FileHeader{
+		Version:        FileVersion{Major: 5, Minor: 0, Micro: 3, Extra: 4},
+		Fp:             FpCompressed | FpKOGLLicense,
+		Sp:             SpLicenseInfo | SpCopyWithoutModification,
+		EncryptVersion: 4,
+		KOGLCountry:    6,
+	}
+	s := h.Summary()
+
+	if s.Version != "5.0.3.4" {
+		t.Errorf("expected version 5.0.3.4, got %s", s.Version)
+	}
+	if len(s.Flags) != 2 || s.Flags[0] != "compressed" || s.Flags[1] != "kogl" {
+		t.Errorf("unexpected flags %v", s.Flags)
+	}
+	if s.Encrypted || s.Encryption != "Hancom 7.0 and newer" {
+		t.Errorf("unexpected encryption %v %s", s.Encrypted, s.Encryption)
+	}
+	if s.License != "KOGL" || s.KOGLCountry != "KR" || s.CopyProtected ||
+		!s.CopyWithoutModification {
+		t.Errorf("unexpected license %q %q %v %v", s.License, s.KOGLCountry,
+			s.CopyProtected, s.CopyWithoutModification)
+	}
+
+	want := "Version:\t5.0.3.4\n" +
+		"Flags:\t\tcompressed, kogl\n" +
+		"Encryption:\tnot encrypted (Hancom 7.0 and newer)\n" +
+		"License:\tKOGL (KR)\n" +
+		"Copying:\tallowed without modification\n"
+	if s.String() != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, s.String())
+	}
+}
+
+// TestFileVersion checks parsing, printing and comparing versions.
+// TestFileVersion은 버전을 읽고, 출력하고, 비교하는 것을 확인합니다.
+func TestFileVersion(t *testing.T) {
+	v, err := ParseFileVersion("5.0.3.4")
 	if err != nil {
 		t.Fatal(err)
 	}
-	fmt.Println("FileVersion", h.Version.Major, h.Version.Minor,
-		h.Version.Micro, h.Version.Extra)
-
-	fmt.Println("")
-	fmt.Println("IsCompressed?:", h.Fp.IsCompressed())
-	fmt.Println("IsEncrypted?:", h.Fp.IsEncrypted())
-	fmt.Println("IsExported?:", h.Fp.IsExported())
-	fmt.Println("HasScript?:", h.Fp.HasScript())
-	fmt.Println("HasDRM?:", h.Fp.HasDRM())
-	fmt.Println("HasXMLTemplateStorage?:", h.Fp.HasXMLTemplateStorage())
-	fmt.Println("HasFileHistory?:", h.Fp.HasFileHistory())
-	fmt.Println("HasDigitalSig?:", h.Fp.HasDigitalSig())
-	fmt.Println("IsEncryptedWithKISAKey?:", h.Fp.IsEncryptedWithKISAKey())
-	fmt.Println("HasSpareDigitalSig?:", h.Fp.HasSpareDigitalSig())
-	fmt.Println("HasKISADRM?:", h.Fp.HasKISADRM())
-	fmt.Println("HasCCL?:", h.Fp.HasCCL())
-	fmt.Println("IsMobileOptimized?:", h.Fp.IsMobileOptimized())
-	fmt.Println("IsPrivateInfoProtected?:", h.Fp.IsPrivateInfoProtected())
-	fmt.Println("IsModificationTracked?:", h.Fp.IsModificationTracked())
-	fmt.Println("HasKOGLLicense?:", h.Fp.HasKOGLLicense())
-	fmt.Println("HasVideoControls?:", h.Fp.HasVideoControls())
-	fmt.Println("HasChapterControlField?:", h.Fp.HasChapterControlField())
-
-	fmt.Println("")
-	fmt.Println("HasLicenseInfo?:", h.Sp.HasLicenseInfo())
-	fmt.Println("IsCopyProtected?:", h.Sp.IsCopyProtected())
-	fmt.Println("IsAllowedToCopyWithoutModification?:",
-		h.Sp.IsAllowedToCopyWithoutModification())
-
-	fmt.Println("")
-	fmt.Println("EncryptVersion", h.EncryptVersion)
-
-	fmt.Println("")
-	fmt.Println("KOGLCountry", h.KOGLCountry)
+	if want := (FileVersion{5, 0, 3, 4}); v != want {
+		t.Errorf("expected %v, got %v", want, v)
+	}
+	if v.String() != "5.0.3.4" {
+		t.Errorf("unexpected string %q", v.String())
+	}
+
+	for _, s := range []string{"", "5.0.3", "5.0.3.4.1", "5.0.x.4", "5.0.256.0"} {
+		if _, err := ParseFileVersion(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"5.0.3.4", "5.0.3.4", 0},
+		{"5.0.3.4", "5.0.3.5", -1},
+		{"5.0.3.4", "5.0.2.9", 1},
+		{"5.1.0.0", "5.0.9.9", 1},
+		{"4.9.9.9", "5.0.0.0", -1},
+	}
+	for _, test := range tests {
+		a, _ := ParseFileVersion(test.a)
+		b, _ := ParseFileVersion(test.b)
+		if got := a.Compare(b); got != test.want {
+			t.Errorf("%s vs %s: expected %d, got %d", test.a, test.b,
+				test.want, got)
+		}
+	}
+
+	if !v.AtLeast(5, 0, 3, 2) || !v.AtLeast(5, 0, 3, 4) || v.AtLeast(5, 0, 3, 5) {
+		t.Errorf("unexpected AtLeast for %v", v)
+	}
+
+	if !v.IsCompatible(SupportedVersion) {
+		t.Errorf("%v should be compatible", v)
+	}
+	for _, other := range []FileVersion{{5, 1, 0, 0}, {3, 0, 0, 0}} {
+		if other.IsCompatible(SupportedVersion) {
+			t.Errorf("%v should not be compatible", other)
+		}
+	}
 }
@@ -1,17 +1,303 @@
 package hwp50
 
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/richardlehane/mscfb"
+)
+
 // Hwp is a representation of the entire Hwp file
 // This can be parsed into xml
+//
+// Hwp는 hwp 파일 전체를 나타냅니다.
 type Hwp struct {
-	DocInfo               DocInfo
-	PrvText               []byte
-	Scripts               []byte
-	DefaultJScript        []byte
-	BodyText              []BodyText
-	PrvImage              []byte
-	DocOptions            []byte
-	FileHeader            FileHeader
+	FileHeader FileHeader
+
+	DocInfo DocInfo
+
+	// BodyText holds one entry per BodyText/SectionN stream, ordered by N.
+	//
+	// BodyText는 BodyText/SectionN 스트림마다 하나씩, N 순서대로 담고
+	// 있습니다.
+	BodyText []BodyText
+
+	// BinData holds the raw streams of the BinData storage keyed by
+	// their stream name (e.g. "BIN0001.jpg").
+	BinData map[string][]byte
+
+	PrvText  []byte
+	PrvImage []byte
+
+	// Scripts holds the streams of the Scripts storage keyed by name.
+	// DefaultJScript is also kept on its own for convenience.
+	Scripts        map[string][]byte
+	DefaultJScript []byte
+
+	DocOptions  map[string][]byte
+	DocHistory  map[string][]byte
+	XMLTemplate map[string][]byte
+
 	HwpSummaryInformation []byte
+
+	// docInfoRecords are the records of the DocInfo stream.
+	docInfoRecords []*Record
+
+	// streams holds every stream of the compound file keyed by its
+	// slash separated path (e.g. "BodyText/Section0").
+	streams map[string][]byte
+}
+
+// Names of the storages and streams a hwp 5.0 compound file is made of.
+//
+// hwp 5.0 복합 파일을 이루는 스토리지와 스트림의 이름들입니다.
+const (
+	streamFileHeader  = "FileHeader"
+	streamDocInfo     = "DocInfo"
+	storageBodyText   = "BodyText"
+	storageBinData    = "BinData"
+	streamPrvText     = "PrvText"
+	streamPrvImage    = "PrvImage"
+	storageScripts    = "Scripts"
+	storageDocOptions = "DocOptions"
+	storageDocHistory = "DocHistory"
+	storageXMLTmpl    = "XMLTemplate"
+
+	// The summary stream is really named "\005HwpSummaryInformation" but
+	// mscfb drops the leading non printable character.
+	streamSummaryInfo = "HwpSummaryInformation"
+
+	streamDefaultJScript = "DefaultJScript"
+	sectionPrefix        = "Section"
+)
+
+// ErrNotCompoundFile is returned by Open when the file isn't a compound
+// file, which every hwp 5.0 file is.
+//
+// ErrNotCompoundFile은 파일이 복합 파일이 아닐 때 Open이 반환합니다. 모든
+// hwp 5.0 파일은 복합 파일입니다.
+var ErrNotCompoundFile = errors.New("not a compound file 복합 파일이 아닙니다")
+
+// OpenFile opens the hwp file at the given path and parses it.
+//
+// OpenFile은 주어진 경로의 hwp 파일을 열고 읽습니다.
+func OpenFile(name string) (*Hwp, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return Open(f, fi.Size())
+}
+
+// Open reads every stream of the hwp compound file in r and routes them
+// into the matching fields of a Hwp. r is only used during the call.
+//
+// Open은 r의 hwp 복합 파일의 모든 스트림을 읽어 Hwp의 각 필드에
+// 채워 넣습니다. r은 호출 중에만 사용됩니다.
+func Open(r io.ReaderAt, size int64) (*Hwp, error) {
+	doc, err := mscfb.New(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotCompoundFile, err)
+	}
+
+	hwp := &Hwp{streams: make(map[string][]byte)}
+
+	// weird c style for loop
+	for entry, err := doc.Next(); err != io.EOF; entry, err = doc.Next() {
+		if err != nil {
+			return nil, err
+		}
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+
+		name := path.Join(append(entry.Path, entry.Name)...)
+
+		// The size comes from the directory entry, so it's checked
+		// against the file and the data grows as it's read rather than
+		// being allocated up front.
+		// 크기는 디렉터리 항목에 적힌 값이라 파일 크기와 비교하고,
+		// 미리 할당하지 않고 읽는 만큼 늘립니다.
+		if entry.Size > size {
+			return nil, fmt.Errorf("reading stream %s: size %d is "+
+				"larger than the file of %d bytes", name, entry.Size, size)
+		}
+		data, err := io.ReadAll(io.LimitReader(entry, entry.Size))
+		if err == nil && int64(len(data)) < entry.Size {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading stream %s: %w", name, err)
+		}
+
+		hwp.route(entry.Path, entry.Name, data)
+	}
+
+	err = hwp.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	return hwp, nil
+}
+
+// route stores a stream in the field of hwp that matches its location in
+// the compound file.
+func (hwp *Hwp) route(dir []string, name string, data []byte) {
+	hwp.streams[path.Join(append(dir, name)...)] = data
+
+	if len(dir) == 0 {
+		switch name {
+		case streamPrvText:
+			hwp.PrvText = data
+		case streamPrvImage:
+			hwp.PrvImage = data
+		case streamSummaryInfo:
+			hwp.HwpSummaryInformation = data
+		}
+		return
+	}
+
+	var storage *map[string][]byte
+	switch dir[0] {
+	case storageBinData:
+		storage = &hwp.BinData
+	case storageScripts:
+		storage = &hwp.Scripts
+		if name == streamDefaultJScript {
+			hwp.DefaultJScript = data
+		}
+	case storageDocOptions:
+		storage = &hwp.DocOptions
+	case storageDocHistory:
+		storage = &hwp.DocHistory
+	case storageXMLTmpl:
+		storage = &hwp.XMLTemplate
+	default:
+		return
+	}
+
+	if *storage == nil {
+		*storage = make(map[string][]byte)
+	}
+	(*storage)[path.Join(append(dir[1:], name)...)] = data
+}
+
+// decode parses the streams gathered by route.
+func (hwp *Hwp) decode() error {
+	header, ok := hwp.streams[streamFileHeader]
+	if !ok {
+		return ErrMissingFileHeader
+	}
+	err := hwp.FileHeader.DeserializeFileHeader(bytes.NewReader(header))
+	if err != nil {
+		return err
+	}
+
+	if !hwp.FileHeader.Version.IsCompatible(SupportedVersion) {
+		return fmt.Errorf("%w: %v", ErrIncompatibleVersion,
+			hwp.FileHeader.Version)
+	}
+
+	hwp.docInfoRecords, err = hwp.readRecords(streamDocInfo)
+	if err != nil {
+		return err
+	}
+	err = hwp.DocInfo.decode(hwp.docInfoRecords, hwp.FileHeader.Version)
+	if err != nil {
+		return fmt.Errorf("%s: %w", streamDocInfo, err)
+	}
+
+	sections := hwp.sectionNames()
+	hwp.BodyText = make([]BodyText, len(sections))
+	for i, name := range sections {
+		records, err := hwp.readRecords(name)
+		if err != nil {
+			return err
+		}
+		section := &hwp.BodyText[i]
+		section.nodes = BuildRecordTree(records)
+		section.Paragraphs, err = paragraphs(section.nodes,
+			hwp.FileHeader.Version)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// readRecords reads every record of the stream at name.
+func (hwp *Hwp) readRecords(name string) ([]*Record, error) {
+	rc, err := hwp.OpenStream(name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	records, err := ReadRecords(rc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return records, nil
+}
+
+// Streams returns the paths of every stream in the file, sorted.
+//
+// Streams는 파일의 모든 스트림 경로를 정렬해서 반환합니다.
+func (hwp *Hwp) Streams() []string {
+	names := make([]string, 0, len(hwp.streams))
+	for name := range hwp.streams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sectionNames returns the paths of the BodyText/SectionN streams sorted
+// by N.
+func (hwp *Hwp) sectionNames() []string {
+	type section struct {
+		n    int
+		name string
+	}
+
+	var sections []section
+	for name := range hwp.streams {
+		dir, file := path.Split(name)
+		if dir != storageBodyText+"/" || !strings.HasPrefix(file, sectionPrefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(file, sectionPrefix))
+		if err != nil {
+			continue
+		}
+		sections = append(sections, section{n, name})
+	}
+
+	sort.Slice(sections, func(i, j int) bool {
+		return sections[i].n < sections[j].n
+	})
+
+	names := make([]string, len(sections))
+	for i, s := range sections {
+		names[i] = s.name
+	}
+	return names
 }
 
 // TODO
@@ -0,0 +1,75 @@
+package hwp50
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestOpenFile opens the testdata file and checks that its streams were
+// routed into the Hwp struct.
+//
+// TestOpenFile은 testdata 파일을 열어 스트림들이 Hwp struct에 제대로
+// 들어갔는지 확인합니다.
+func TestOpenFile(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hwp.FileHeader.Sig != Signature {
+		t.Errorf("bad signature %q", hwp.FileHeader.Sig)
+	}
+	if len(hwp.BodyText) != 1 {
+		t.Errorf("expected 1 section, got %d", len(hwp.BodyText))
+	}
+	if len(hwp.PrvText) == 0 {
+		t.Error("PrvText is empty")
+	}
+	if len(hwp.PrvImage) == 0 {
+		t.Error("PrvImage is empty")
+	}
+	if len(hwp.DefaultJScript) == 0 {
+		t.Error("DefaultJScript is empty")
+	}
+	if _, ok := hwp.Scripts["JScriptVersion"]; !ok {
+		t.Error("Scripts/JScriptVersion missing")
+	}
+	if _, ok := hwp.DocOptions["_LinkDoc"]; !ok {
+		t.Error("DocOptions/_LinkDoc missing")
+	}
+	if len(hwp.HwpSummaryInformation) == 0 {
+		t.Error("HwpSummaryInformation is empty")
+	}
+}
+
+// TestOpenStream checks that compressed streams are inflated and that a
+// missing stream is reported with ErrStreamNotFound.
+//
+// TestOpenStream은 압축된 스트림이 풀리는지와 없는 스트림이
+// ErrStreamNotFound를 반환하는지 확인합니다.
+func TestOpenStream(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !hwp.FileHeader.Fp.IsCompressed() {
+		t.Fatal("testdata is expected to be compressed")
+	}
+
+	for _, name := range []string{"DocInfo", "BodyText/Section0"} {
+		data, err := hwp.readStream(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) <= len(hwp.streams[name]) {
+			t.Errorf("%s: inflated %d bytes from %d compressed bytes",
+				name, len(data), len(hwp.streams[name]))
+		}
+	}
+
+	_, err = hwp.OpenStream("BodyText/Section9")
+	if !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("expected ErrStreamNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package hwp50
+
+// MemoKind is what a memo is for.
+//
+// MemoKind는 메모의 종류입니다.
+type MemoKind uint32
+
+const (
+	MemoNormal MemoKind = iota
+	MemoUserInsert
+	MemoUserDelete
+	MemoUserUpdate
+)
+
+// MemoShape is the look of the box memos (comments) are shown in.
+//
+// The spec gives only the size of the record. The fields follow the memoPr
+// element of HWPX, which stores the same settings.
+//
+// MemoShape는 메모가 표시되는 상자의 모양입니다. 스펙에는 레코드 크기만
+// 있어서 같은 설정을 담는 HWPX의 memoPr 요소를 따라 읽습니다.
+type MemoShape struct {
+	// Width is the width of the memo box in HWPUNIT.
+	Width uint32
+
+	// The border of the memo box.
+	LineThickness LineThickness
+	LineType      LineType
+	LineColor     ColorRef
+
+	FillColor ColorRef
+
+	// ActiveColor is the fill color of the memo being edited.
+	ActiveColor ColorRef
+
+	Kind MemoKind
+}
+
+func (ms *MemoShape) deserialize(data []byte) error {
+	d := newDataReader(data)
+	ms.Width = d.uint32()
+	ms.LineThickness = LineThickness(d.uint8())
+	ms.LineType = LineType(d.uint8())
+	ms.LineColor = ColorRef(d.uint32())
+	ms.FillColor = ColorRef(d.uint32())
+	ms.ActiveColor = ColorRef(d.uint32())
+	ms.Kind = MemoKind(d.uint32())
+	return d.err
+}
+
+func decodeMemoShape(rec *Record, ver FileVersion) (interface{}, error) {
+	ms := new(MemoShape)
+	err := ms.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+func init() {
+	registerDecoder(TagMemoShape, decodeMemoShape)
+}
@@ -0,0 +1,33 @@
+package hwp50
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestDecodeMemoShape decodes a memo shape and checks every field.
+//
+// TestDecodeMemoShape는 메모 모양을 읽고 모든 필드를 확인합니다.
+func TestDecodeMemoShape(t *testing.T) {
+	data := littleEndian(uint32(15591), uint8(7), LineDash,
+		uint32(0xaed7b6), uint32(0xe9fff0), uint32(0xc7f1cf), MemoUserDelete)
+
+	v, err := DecodeRecord(&Record{TagID: TagMemoShape, Data: data},
+		FileVersion{5, 0, 2, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms := v.(*MemoShape)
+	if ms.Width != 15591 || ms.LineThickness != 7 || ms.LineType != LineDash ||
+		ms.LineColor.String() != "#b6d7ae" ||
+		ms.FillColor.String() != "#f0ffe9" ||
+		ms.ActiveColor.String() != "#cff1c7" || ms.Kind != MemoUserDelete {
+		t.Errorf("unexpected memo shape %+v", ms)
+	}
+
+	var short MemoShape
+	err = short.deserialize(data[:21])
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
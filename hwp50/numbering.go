@@ -0,0 +1,486 @@
+package hwp50
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// numberingLevels is the number of levels of a Numbering.
+const numberingLevels = 7
+
+// HeadAlign is the alignment of a number or bullet within its width.
+//
+// HeadAlign은 번호나 글머리표의 정렬입니다.
+type HeadAlign uint8
+
+const (
+	HeadAlignLeft HeadAlign = iota
+	HeadAlignCenter
+	HeadAlignRight
+)
+
+// NumberShape is how a number is written, e.g. 1, ①, 가 or Ⅰ.
+//
+// NumberShape는 번호를 쓰는 방식입니다. 예: 1, ①, 가, Ⅰ.
+type NumberShape uint8
+
+const (
+	NumberDigit NumberShape = iota
+	NumberCircledDigit
+	NumberRomanUpper
+	NumberRomanLower
+	NumberLatinUpper
+	NumberLatinLower
+	NumberCircledLatinUpper
+	NumberCircledLatinLower
+	NumberHangulSyllable
+	NumberCircledHangulSyllable
+	NumberHangulJamo
+	NumberCircledHangulJamo
+	NumberHangulPhonetic
+	NumberIdeograph
+	NumberCircledIdeograph
+)
+
+// ParaHead is the head info shared by numberings and bullets.
+//
+// ParaHead는 문단 번호와 글머리표가 같이 쓰는 문단 머리 정보입니다.
+type ParaHead struct {
+	Property uint32
+
+	// WidthAdjust corrects the width of the number in HWPUNIT.
+	WidthAdjust uint16
+
+	// TextOffset is the space between the number and the text, in
+	// percent or HWPUNIT depending on TextOffsetIsValue.
+	TextOffset uint16
+
+	// CharShapeID is the char shape of the number. 0xFFFFFFFF means the
+	// char shape of the paragraph is used.
+	CharShapeID uint32
+}
+
+// Align returns bits 0~1
+func (h *ParaHead) Align() HeadAlign {
+	return HeadAlign(bits32(h.Property, 0, 2))
+}
+
+// UsesInstanceWidth reports if the number is as wide as its text rather
+// than a fixed width.
+func (h *ParaHead) UsesInstanceWidth() bool {
+	return bits32(h.Property, 2, 1) != 0
+}
+
+func (h *ParaHead) AutoOutdent() bool {
+	return bits32(h.Property, 3, 1) != 0
+}
+
+// TextOffsetIsValue reports if TextOffset is in HWPUNIT rather than in
+// percent.
+func (h *ParaHead) TextOffsetIsValue() bool {
+	return bits32(h.Property, 4, 1) != 0
+}
+
+// NumberShape returns bits 5~8. The spec leaves them out but files keep
+// the shape of the number there.
+func (h *ParaHead) NumberShape() NumberShape {
+	return NumberShape(bits32(h.Property, 5, 4))
+}
+
+func (h *ParaHead) deserialize(d *dataReader) {
+	h.Property = d.uint32()
+	h.WidthAdjust = d.uint16()
+	h.TextOffset = d.uint16()
+	h.CharShapeID = d.uint32()
+}
+
+// NumberingLevel is a level of a Numbering.
+//
+// NumberingLevel은 Numbering의 수준 하나입니다.
+type NumberingLevel struct {
+	Head ParaHead
+
+	// Format is the text of the number, where ^n stands for the number of
+	// level n, e.g. "^1." or "(^5)".
+	Format string
+}
+
+// Numbering is a set of number formats, one per level, used for outlines
+// and numbered paragraphs. Ids pointing at numberings start at 1.
+//
+// Numbering은 개요와 문단 번호에 쓰이는 수준별 번호 형식입니다.
+// Numbering을 가리키는 id는 1부터 시작합니다.
+type Numbering struct {
+	Levels [numberingLevels]NumberingLevel
+
+	// Start is the number the numbering starts at.
+	Start uint16
+
+	// LevelStart is the number each level starts at.
+	// Only relevant for hwp 5.0.2.5 and up
+	LevelStart [numberingLevels]uint32
+}
+
+// start returns the first number of level.
+func (n *Numbering) start(level int) uint32 {
+	if n.LevelStart[level] != 0 {
+		return n.LevelStart[level]
+	}
+	if level == 0 && n.Start != 0 {
+		return uint32(n.Start)
+	}
+	return 1
+}
+
+// Label formats level with the numbers of every level up to it.
+//
+// Label은 level까지의 각 수준 번호로 level의 번호를 만듭니다.
+func (n *Numbering) Label(level int, numbers [numberingLevels]uint32) string {
+	format := []rune(n.Levels[level].Format)
+
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] == '^' && i+1 < len(format) &&
+			format[i+1] >= '1' && format[i+1] <= '0'+numberingLevels {
+			l := int(format[i+1] - '1')
+			b.WriteString(FormatNumber(numbers[l], n.Levels[l].Head.NumberShape()))
+			i++
+			continue
+		}
+		b.WriteRune(format[i])
+	}
+	return b.String()
+}
+
+func (n *Numbering) deserialize(data []byte, ver FileVersion) error {
+	d := newDataReader(data)
+	for i := range n.Levels {
+		n.Levels[i].Head.deserialize(d)
+		n.Levels[i].Format = d.string()
+	}
+	n.Start = d.uint16()
+
+	if ver.AtLeast(5, 0, 2, 5) && d.remaining() >= 4*numberingLevels {
+		for i := range n.LevelStart {
+			n.LevelStart[i] = d.uint32()
+		}
+	}
+
+	// Newer files may go on with levels 8 to 10, which aren't read.
+	return d.err
+}
+
+func decodeNumbering(rec *Record, ver FileVersion) (interface{}, error) {
+	n := new(Numbering)
+	err := n.deserialize(rec.Data, ver)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// ImageBullet is the image of a bullet.
+//
+// ImageBullet은 글머리표의 그림입니다.
+type ImageBullet struct {
+	Brightness int8
+	Contrast   int8
+	Effect     ImageEffect
+
+	// BinItem is the id of the BinData, starting at 1.
+	BinItem uint8
+}
+
+// Bullet is a bullet char or image put before paragraphs. Ids pointing at
+// bullets start at 1.
+//
+// Bullet은 문단 앞에 붙는 글머리표 글자나 그림입니다. Bullet을 가리키는
+// id는 1부터 시작합니다.
+type Bullet struct {
+	Head ParaHead
+
+	Char rune
+
+	// ImageID is nonzero if the bullet is Image rather than Char.
+	ImageID int32
+
+	Image ImageBullet
+
+	// CheckChar is the bullet of checked items.
+	CheckChar rune
+}
+
+// IsImage reports if the bullet is an image.
+func (b *Bullet) IsImage() bool {
+	return b.ImageID != 0
+}
+
+func (b *Bullet) deserialize(data []byte) error {
+	d := newDataReader(data)
+	b.Head.deserialize(d)
+	b.Char = rune(d.uint16())
+
+	if d.remaining() >= 8 {
+		b.ImageID = d.int32()
+		b.Image = ImageBullet{
+			Brightness: d.int8(),
+			Contrast:   d.int8(),
+			Effect:     ImageEffect(d.uint8()),
+			BinItem:    d.uint8(),
+		}
+	}
+	if d.remaining() >= 2 {
+		b.CheckChar = rune(d.uint16())
+	}
+
+	return d.err
+}
+
+func decodeBullet(rec *Record, ver FileVersion) (interface{}, error) {
+	b := new(Bullet)
+	err := b.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func init() {
+	registerDecoder(TagNumbering, decodeNumbering)
+	registerDecoder(TagBullet, decodeBullet)
+}
+
+// Sequences the letter like number shapes count through.
+var (
+	hangulSyllables = []rune("가나다라마바사아자차카타파하")
+	hangulJamos     = []rune("ㄱㄴㄷㄹㅁㅂㅅㅇㅈㅊㅋㅌㅍㅎ")
+	hangulDigits    = []rune("영일이삼사오육칠팔구")
+	ideographDigits = []rune("〇一二三四五六七八九")
+)
+
+// FormatNumber writes n in the given shape. Numbers the shape has no
+// character for are written as digits.
+//
+// FormatNumber는 n을 주어진 모양으로 씁니다. 모양에 해당하는 글자가 없는
+// 번호는 숫자로 씁니다.
+func FormatNumber(n uint32, shape NumberShape) string {
+	digits := strconv.FormatUint(uint64(n), 10)
+
+	switch shape {
+	case NumberCircledDigit:
+		return circled(n, 20, '①', digits)
+	case NumberRomanUpper:
+		return roman(n, digits)
+	case NumberRomanLower:
+		return strings.ToLower(roman(n, digits))
+	case NumberLatinUpper:
+		return letter(n, 'A', 26, digits)
+	case NumberLatinLower:
+		return letter(n, 'a', 26, digits)
+	case NumberCircledLatinUpper:
+		return circled(n, 26, 'Ⓐ', digits)
+	case NumberCircledLatinLower:
+		return circled(n, 26, 'ⓐ', digits)
+	case NumberHangulSyllable:
+		return cycle(n, hangulSyllables, digits)
+	case NumberCircledHangulSyllable:
+		return circled(n, 14, '㉮', digits)
+	case NumberHangulJamo:
+		return cycle(n, hangulJamos, digits)
+	case NumberCircledHangulJamo:
+		return circled(n, 14, '㉠', digits)
+	case NumberHangulPhonetic:
+		return sinoKorean(n, hangulDigits, "십백천", digits)
+	case NumberIdeograph:
+		return sinoKorean(n, ideographDigits, "十百千", digits)
+	case NumberCircledIdeograph:
+		return circled(n, 10, '㊀', digits)
+	}
+	return digits
+}
+
+// circled returns the n-th of count consecutive characters starting at
+// first.
+func circled(n, count uint32, first rune, digits string) string {
+	if n < 1 || n > count {
+		return digits
+	}
+	return string(first + rune(n-1))
+}
+
+// letter returns A to Z for 1 to 26, then AA, BB and so on.
+func letter(n uint32, first rune, count uint32, digits string) string {
+	if n < 1 {
+		return digits
+	}
+	c := string(first + rune((n-1)%count))
+	return strings.Repeat(c, int((n-1)/count)+1)
+}
+
+// cycle returns the n-th of seq, starting over after the last one.
+func cycle(n uint32, seq []rune, digits string) string {
+	if n < 1 {
+		return digits
+	}
+	return string(seq[(n-1)%uint32(len(seq))])
+}
+
+// roman returns n in upper case roman numerals.
+func roman(n uint32, digits string) string {
+	if n < 1 || n >= 4000 {
+		return digits
+	}
+
+	values := []uint32{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+
+	var b strings.Builder
+	for i, v := range values {
+		for n >= v {
+			b.WriteString(symbols[i])
+			n -= v
+		}
+	}
+	return b.String()
+}
+
+// sinoKorean writes n below 10000 with number words, e.g. 이십삼 or 二十三.
+// units are the words for ten, hundred and thousand.
+func sinoKorean(n uint32, words []rune, units string, digits string) string {
+	if n < 1 || n >= 10000 {
+		return digits
+	}
+
+	unit := []rune(units)
+	var b strings.Builder
+	for i := 3; i >= 0; i-- {
+		place := uint32(1)
+		for j := 0; j < i; j++ {
+			place *= 10
+		}
+		d := n / place % 10
+		if d == 0 {
+			continue
+		}
+		// 일 is left out before the units: 십, not 일십.
+		if d != 1 || i == 0 {
+			b.WriteRune(words[d])
+		}
+		if i > 0 {
+			b.WriteRune(unit[i-1])
+		}
+	}
+	return b.String()
+}
+
+// Numberer computes the labels of numbered paragraphs, like "1." or "가.",
+// counting the paragraphs in the order they're given.
+//
+// Numberer는 주어진 순서대로 문단을 세어 "1.", "가." 같은 문단 번호를
+// 만듭니다.
+type Numberer struct {
+	di *DocInfo
+
+	// outline is the numbering id of outline headings.
+	outline uint16
+
+	// numbers holds the current number of each level per numbering id.
+	// A level that isn't started yet is zero.
+	numbers map[uint16]*[numberingLevels]uint32
+}
+
+// NewNumberer returns a Numberer using the numberings and bullets of di.
+//
+// NewNumberer는 di의 문단 번호와 글머리표를 쓰는 Numberer를 반환합니다.
+func NewNumberer(di *DocInfo) *Numberer {
+	return &Numberer{
+		di:      di,
+		numbers: make(map[uint16]*[numberingLevels]uint32),
+	}
+}
+
+// SetOutline sets the numbering id of outline headings. Every section
+// sets its own.
+//
+// SetOutline은 개요 번호의 numbering id를 정합니다. 구역마다 따로
+// 정합니다.
+func (nr *Numberer) SetOutline(id uint16) {
+	nr.outline = id
+}
+
+// Label returns the number or bullet of p, or "" if it has none.
+//
+// Label은 p의 번호나 글머리표를 반환합니다. 없으면 ""를 반환합니다.
+func (nr *Numberer) Label(p *Paragraph) (string, error) {
+	psID := p.Header.ParaShapeID
+	if int(psID) >= len(nr.di.ParagraphShape) {
+		return "", fmt.Errorf("%w: para shape %d", ErrInvalidID, psID)
+	}
+	ps := nr.di.ParagraphShape[psID]
+
+	var id uint16
+	switch ps.Heading() {
+	case HeadingOutline:
+		id = nr.outline
+	case HeadingNumbering:
+		id = ps.NumberingID
+	case HeadingBullet:
+		return nr.bullet(ps.NumberingID)
+	}
+	if id == 0 {
+		return "", nil
+	}
+	if int(id) > len(nr.di.Numbering) {
+		return "", fmt.Errorf("%w: numbering %d", ErrInvalidID, id)
+	}
+	numbering := nr.di.Numbering[id-1]
+
+	level := int(ps.Level())
+	if level >= numberingLevels {
+		level = numberingLevels - 1
+	}
+
+	numbers := nr.numbers[id]
+	if numbers == nil {
+		numbers = new([numberingLevels]uint32)
+		nr.numbers[id] = numbers
+	}
+
+	if numbers[level] == 0 {
+		numbers[level] = numbering.start(level)
+	} else {
+		numbers[level]++
+	}
+	// The levels below start over.
+	// 하위 수준은 다시 시작합니다.
+	for l := level + 1; l < numberingLevels; l++ {
+		numbers[l] = 0
+	}
+
+	// Levels above that haven't been used yet show their first number.
+	// 아직 쓰이지 않은 상위 수준은 시작 번호를 보여줍니다.
+	shown := *numbers
+	for l := 0; l < level; l++ {
+		if shown[l] == 0 {
+			shown[l] = numbering.start(l)
+		}
+	}
+
+	return numbering.Label(level, shown), nil
+}
+
+// bullet returns the char of the bullet with the given id.
+func (nr *Numberer) bullet(id uint16) (string, error) {
+	if id == 0 {
+		return "", nil
+	}
+	if int(id) > len(nr.di.Bullet) {
+		return "", fmt.Errorf("%w: bullet %d", ErrInvalidID, id)
+	}
+	b := nr.di.Bullet[id-1]
+	if b.IsImage() || b.Char == 0 {
+		return "", nil
+	}
+	return string(b.Char), nil
+}
@@ -0,0 +1,267 @@
+package hwp50
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestTabDefs checks the tab defs of the testdata file and one with stops.
+//
+// TestTabDefs는 testdata 파일의 탭 정의와 탭 위치가 있는 탭 정의를
+// 확인합니다.
+func TestTabDefs(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defs := hwp.DocInfo.TabDef
+	if len(defs) != 2 {
+		t.Fatalf("expected 2 tab defs, got %d", len(defs))
+	}
+	if defs[0].AutoTabLeft() || !defs[1].AutoTabLeft() || defs[1].AutoTabRight() {
+		t.Errorf("unexpected properties %#x %#x", defs[0].Property, defs[1].Property)
+	}
+	if len(defs[0].Stops) != 0 || len(defs[1].Stops) != 0 {
+		t.Errorf("unexpected stops %v %v", defs[0].Stops, defs[1].Stops)
+	}
+
+	data := littleEndian(uint32(2), int32(2),
+		int32(4000), TabCenter, LineDot, uint16(0),
+		int32(8000), TabDecimal, LineNone, uint16(0))
+	var td TabDef
+	err = td.deserialize(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TabStop{{4000, TabCenter, LineDot}, {8000, TabDecimal, LineNone}}
+	if !td.AutoTabRight() || len(td.Stops) != 2 || td.Stops[0] != want[0] ||
+		td.Stops[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, td.Stops)
+	}
+
+	err = td.deserialize(data[:20])
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
+
+// TestNumbering checks the numbering of the testdata file.
+//
+// TestNumbering은 testdata 파일의 문단 번호를 확인합니다.
+func TestNumbering(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hwp.DocInfo.Numbering) != 1 {
+		t.Fatalf("expected 1 numbering, got %d", len(hwp.DocInfo.Numbering))
+	}
+	n := hwp.DocInfo.Numbering[0]
+
+	formats := []string{"^1.", "^2.", "^3)", "^4)", "(^5)", "(^6)", "^7"}
+	shapes := []NumberShape{
+		NumberDigit, NumberHangulSyllable, NumberDigit, NumberHangulSyllable,
+		NumberDigit, NumberHangulSyllable, NumberCircledDigit,
+	}
+	for i, level := range n.Levels {
+		if level.Format != formats[i] || level.Head.NumberShape() != shapes[i] {
+			t.Errorf("level %d: unexpected format %q shape %d", i+1,
+				level.Format, level.Head.NumberShape())
+		}
+		if !level.Head.UsesInstanceWidth() || !level.Head.AutoOutdent() ||
+			level.Head.Align() != HeadAlignLeft || level.Head.TextOffset != 50 ||
+			level.Head.CharShapeID != 0xFFFFFFFF {
+			t.Errorf("level %d: unexpected head %+v", i+1, level.Head)
+		}
+		if n.LevelStart[i] != 1 {
+			t.Errorf("level %d: unexpected start %d", i+1, n.LevelStart[i])
+		}
+	}
+
+	if hwp.BodyText[0].outlineNumbering() != 1 {
+		t.Errorf("expected outline numbering 1, got %d",
+			hwp.BodyText[0].outlineNumbering())
+	}
+}
+
+// TestFormatNumber writes numbers in every shape.
+//
+// TestFormatNumber는 모든 모양으로 번호를 씁니다.
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		n     uint32
+		shape NumberShape
+		want  string
+	}{
+		{12, NumberDigit, "12"},
+		{3, NumberCircledDigit, "③"},
+		{21, NumberCircledDigit, "21"},
+		{1994, NumberRomanUpper, "MCMXCIV"},
+		{4, NumberRomanLower, "iv"},
+		{2, NumberLatinUpper, "B"},
+		{28, NumberLatinLower, "bb"},
+		{3, NumberCircledLatinUpper, "Ⓒ"},
+		{1, NumberCircledLatinLower, "ⓐ"},
+		{3, NumberHangulSyllable, "다"},
+		{15, NumberHangulSyllable, "가"},
+		{14, NumberCircledHangulSyllable, "㉻"},
+		{2, NumberHangulJamo, "ㄴ"},
+		{1, NumberCircledHangulJamo, "㉠"},
+		{23, NumberHangulPhonetic, "이십삼"},
+		{110, NumberHangulPhonetic, "백십"},
+		{1001, NumberIdeograph, "千一"},
+		{10, NumberCircledIdeograph, "㊉"},
+		{0, NumberHangulSyllable, "0"},
+		{5, NumberShape(15), "5"},
+	}
+	for _, test := range tests {
+		if got := FormatNumber(test.n, test.shape); got != test.want {
+			t.Errorf("%d in shape %d: expected %q, got %q", test.n,
+				test.shape, test.want, got)
+		}
+	}
+}
+
+// TestNumberer numbers outline headings, numbered paragraphs and bullets.
+//
+// TestNumberer는 개요, 번호 문단, 글머리표에 번호를 매깁니다.
+func TestNumberer(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Para shape 0 has no heading, 1 to 7 are outline levels, 8 and 9 are
+	// numbered levels and 10 is a bullet. The outline uses numbering 1 too,
+	// so both count on the same numbers.
+	// 0번은 머리가 없고, 1~7번은 개요 수준, 8, 9번은 번호 수준, 10번은
+	// 글머리표입니다. 개요도 1번 문단 번호를 쓰므로 같은 번호를 이어서
+	// 셉니다.
+	di := DocInfo{Numbering: hwp.DocInfo.Numbering}
+	di.ParagraphShape = append(di.ParagraphShape, &ParaShape{})
+	for level := uint32(0); level < 7; level++ {
+		di.ParagraphShape = append(di.ParagraphShape,
+			&ParaShape{Property1: uint32(HeadingOutline)<<23 | level<<25})
+	}
+	for level := uint32(0); level < 2; level++ {
+		di.ParagraphShape = append(di.ParagraphShape, &ParaShape{
+			Property1:   uint32(HeadingNumbering)<<23 | level<<25,
+			NumberingID: 1,
+		})
+	}
+	di.ParagraphShape = append(di.ParagraphShape, &ParaShape{
+		Property1:   uint32(HeadingBullet) << 23,
+		NumberingID: 1,
+	})
+	di.Bullet = []*Bullet{{Char: '•'}}
+
+	nr := NewNumberer(&di)
+	nr.SetOutline(1)
+
+	shapes := []uint16{1, 0, 2, 2, 3, 7, 1, 2, 8, 9, 9, 10, 8, 9}
+	want := []string{"1.", "", "가.", "나.", "1)", "①", "2.", "가.",
+		"3.", "가.", "나.", "•", "4.", "가."}
+	var got []string
+	for _, id := range shapes {
+		label, err := nr.Label(&Paragraph{Header: ParaHeader{ParaShapeID: id}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, label)
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	nr.SetOutline(2)
+	_, err = nr.Label(&Paragraph{Header: ParaHeader{ParaShapeID: 1}})
+	if !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+	_, err = nr.Label(&Paragraph{Header: ParaHeader{ParaShapeID: 11}})
+	if !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+}
+
+// TestBullet decodes a bullet with an image.
+//
+// TestBullet은 그림이 있는 글머리표를 읽습니다.
+func TestBullet(t *testing.T) {
+	data := littleEndian(uint32(1), uint16(0), uint16(50), uint32(0xFFFFFFFF),
+		uint16('●'), int32(1), int8(-10), int8(20), ImageGrayscale, uint8(3),
+		uint16('☑'))
+
+	var b Bullet
+	err := b.deserialize(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ImageBullet{Brightness: -10, Contrast: 20, Effect: ImageGrayscale,
+		BinItem: 3}
+	if b.Char != '●' || !b.IsImage() || b.Image != want || b.CheckChar != '☑' ||
+		b.Head.Align() != HeadAlignCenter {
+		t.Errorf("unexpected bullet %+v", b)
+	}
+
+	err = b.deserialize(data[:13])
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
+
+// TestWriteTextNumbers checks that numbers are only written when asked for.
+//
+// TestWriteTextNumbers는 요청할 때만 번호를 쓰는지 확인합니다.
+func TestWriteTextNumbers(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var records []*Record
+	for _, text := range []string{"one", "two"} {
+		p := testParagraph(0, text)
+		// Para shape 1 is a numbered paragraph.
+		// 1번 문단 모양은 번호 문단입니다.
+		p[0].Data[8] = 1
+		records = append(records, p...)
+	}
+	paras, err := paragraphs(BuildRecordTree(records), FileVersion{5, 0, 4, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := &Hwp{
+		DocInfo: DocInfo{
+			ParagraphShape: []*ParaShape{{}, {
+				Property1:   uint32(HeadingNumbering) << 23,
+				NumberingID: 1,
+			}},
+			Numbering: hwp.DocInfo.Numbering,
+		},
+		BodyText: []BodyText{{Paragraphs: paras}},
+	}
+
+	text, err := doc.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "one\ntwo\n" {
+		t.Errorf("expected no numbers by default, got %q", text)
+	}
+
+	opts := DefaultTextOptions
+	opts.Numbers = true
+	var b strings.Builder
+	err = doc.WriteTextOptions(&b, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "1. one\n2. two\n" {
+		t.Errorf("expected numbers, got %q", b.String())
+	}
+}
@@ -0,0 +1,262 @@
+package hwp50
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParameterType is the type of the value of a ParameterItem.
+//
+// ParameterType은 ParameterItem 값의 형식입니다.
+type ParameterType uint16
+
+const (
+	ParameterNull ParameterType = iota
+	ParameterBSTR
+	ParameterI1
+	ParameterI2
+	ParameterI4
+	ParameterI
+	ParameterUI1
+	ParameterUI2
+	ParameterUI4
+	ParameterUI
+)
+
+const (
+	// ParameterSetType is a nested ParameterSet.
+	ParameterSetType ParameterType = 0x8000 + iota
+
+	// ParameterArray is a list of ParameterSets.
+	ParameterArray
+
+	// ParameterBinData is the id of a BinData, starting at 1.
+	ParameterBinData
+)
+
+var parameterTypes = map[ParameterType]string{
+	ParameterNull:    "null",
+	ParameterBSTR:    "bstr",
+	ParameterI1:      "i1",
+	ParameterI2:      "i2",
+	ParameterI4:      "i4",
+	ParameterI:       "i",
+	ParameterUI1:     "ui1",
+	ParameterUI2:     "ui2",
+	ParameterUI4:     "ui4",
+	ParameterUI:      "ui",
+	ParameterSetType: "set",
+	ParameterArray:   "array",
+	ParameterBinData: "bindata",
+}
+
+func (t ParameterType) String() string {
+	if s, ok := parameterTypes[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("ParameterType(%#x)", uint16(t))
+}
+
+// MarshalText writes the name of t, so JSON dumps are readable.
+func (t ParameterType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// ErrUnknownParameterType is returned when a ParameterItem has a type
+// whose size isn't known, so the rest of the set can't be read.
+//
+// ErrUnknownParameterType은 ParameterItem의 형식을 몰라서 나머지를 읽을
+// 수 없을 때 반환됩니다.
+var ErrUnknownParameterType = errors.New("unknown parameter type 알 수 없는 파라미터 형식입니다")
+
+// ParameterSet is Hancom's generic tree of typed values, used by DOC_DATA
+// and CTRL_DATA records for things like print settings and field data.
+//
+// ParameterSet은 한컴의 형식 있는 값의 트리입니다. DOC_DATA와 CTRL_DATA
+// 레코드가 인쇄 설정, 필드 데이터 등을 담는 데 씁니다.
+type ParameterSet struct {
+	ID uint16 `json:"id"`
+
+	Items []ParameterItem `json:"items"`
+}
+
+// ParameterItem is a value of a ParameterSet.
+//
+// ParameterItem은 ParameterSet의 값 하나입니다.
+type ParameterItem struct {
+	ID uint16 `json:"id"`
+
+	Type ParameterType `json:"type"`
+
+	// Value is nil for ParameterNull, a string for ParameterBSTR, an
+	// int32 for the signed types, a uint32 for the unsigned types, a
+	// *ParameterSet for ParameterSetType, a []*ParameterSet for
+	// ParameterArray and a uint16 for ParameterBinData.
+	Value interface{} `json:"value"`
+}
+
+// Item returns the item of ps with the given id.
+//
+// Item은 ps에서 주어진 id의 아이템을 반환합니다.
+func (ps *ParameterSet) Item(id uint16) (*ParameterItem, bool) {
+	for i := range ps.Items {
+		if ps.Items[i].ID == id {
+			return &ps.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+// Get returns the item at path, a slash separated list of item ids. The
+// ids of nested sets follow the id of their item and the elements of an
+// array are picked by their index, e.g. "0x4000/2/1". Ids may be decimal
+// or hexadecimal.
+//
+// Get은 path 위치의 아이템을 반환합니다. path는 아이템 id를 /로 이은
+// 것으로, 안쪽 셋의 id가 그 아이템 id 뒤에 오고 배열의 원소는 순번으로
+// 고릅니다. 예: "0x4000/2/1". id는 10진수나 16진수로 씁니다.
+func (ps *ParameterSet) Get(path string) (*ParameterItem, bool) {
+	set := ps
+	var item *ParameterItem
+	parts := strings.Split(path, "/")
+	for i := 0; i < len(parts); i++ {
+		if set == nil {
+			return nil, false
+		}
+		id, err := strconv.ParseUint(parts[i], 0, 16)
+		if err != nil {
+			return nil, false
+		}
+		var ok bool
+		item, ok = set.Item(uint16(id))
+		if !ok {
+			return nil, false
+		}
+
+		set = nil
+		switch v := item.Value.(type) {
+		case *ParameterSet:
+			set = v
+		case []*ParameterSet:
+			if i+1 == len(parts) {
+				break
+			}
+			i++
+			n, err := strconv.ParseUint(parts[i], 0, 16)
+			if err != nil || int(n) >= len(v) {
+				return nil, false
+			}
+			set = v[n]
+		}
+	}
+	return item, true
+}
+
+func (ps *ParameterSet) deserialize(data []byte) error {
+	d := newDataReader(data)
+	err := ps.read(d)
+	if err != nil {
+		return err
+	}
+	return d.err
+}
+
+// read reads ps and the sets nested in it from d.
+func (ps *ParameterSet) read(d *dataReader) error {
+	ps.ID = d.uint16()
+	n := int(d.int16())
+	for i := 0; i < n && d.err == nil; i++ {
+		item := ParameterItem{
+			ID:   d.uint16(),
+			Type: ParameterType(d.uint16()),
+		}
+		err := item.read(d)
+		if err != nil {
+			return err
+		}
+		ps.Items = append(ps.Items, item)
+	}
+	return d.err
+}
+
+// read reads the value of the item from d.
+func (item *ParameterItem) read(d *dataReader) error {
+	switch item.Type {
+	case ParameterNull:
+	case ParameterBSTR:
+		item.Value = d.string()
+	case ParameterI1:
+		item.Value = int32(d.int8())
+	case ParameterI2:
+		item.Value = int32(d.int16())
+	case ParameterI4, ParameterI:
+		item.Value = d.int32()
+	case ParameterUI1:
+		item.Value = uint32(d.uint8())
+	case ParameterUI2:
+		item.Value = uint32(d.uint16())
+	case ParameterUI4, ParameterUI:
+		item.Value = d.uint32()
+	case ParameterSetType:
+		set := new(ParameterSet)
+		err := set.read(d)
+		if err != nil {
+			return err
+		}
+		item.Value = set
+	case ParameterArray:
+		n := int(d.int16())
+		sets := []*ParameterSet{}
+		for i := 0; i < n && d.err == nil; i++ {
+			set := new(ParameterSet)
+			err := set.read(d)
+			if err != nil {
+				return err
+			}
+			sets = append(sets, set)
+		}
+		item.Value = sets
+	case ParameterBinData:
+		item.Value = d.uint16()
+	default:
+		return fmt.Errorf("%w: %v", ErrUnknownParameterType, item.Type)
+	}
+	return d.err
+}
+
+func decodeParameterSet(rec *Record, ver FileVersion) (interface{}, error) {
+	ps := new(ParameterSet)
+	err := ps.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func init() {
+	registerDecoder(TagDocData, decodeParameterSet)
+	registerDecoder(TagCtrlData, decodeParameterSet)
+}
+
+// Parameters returns the parameter set in the CTRL_DATA record of an
+// extended control run, or nil if it has none.
+//
+// Parameters는 확장 컨트롤 run의 CTRL_DATA 레코드의 파라미터 셋을
+// 반환합니다. 없으면 nil을 반환합니다.
+func (r *TextRun) Parameters() (*ParameterSet, error) {
+	if r.CtrlHeader == nil {
+		return nil, nil
+	}
+	node := r.CtrlHeader.Child(TagCtrlData)
+	if node == nil {
+		return nil, nil
+	}
+	ps := new(ParameterSet)
+	err := ps.deserialize(node.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", TagCtrlData, err)
+	}
+	return ps, nil
+}
@@ -0,0 +1,140 @@
+package hwp50
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+// bstr returns s as a BSTR of a parameter item: a WORD count of UTF-16
+// units followed by the units. It's also how records store strings.
+func bstr(s string) []uint16 {
+	w := utf16.Encode([]rune(s))
+	return append([]uint16{uint16(len(w))}, w...)
+}
+
+// parameterSetData is a set with every type of item, a nested set and an
+// array of two sets.
+var parameterSetData = littleEndian(
+	uint16(0x21), int16(14),
+	uint16(1), ParameterNull,
+	uint16(2), ParameterBSTR, bstr("A4"),
+	uint16(3), ParameterI1, int8(-1),
+	uint16(4), ParameterI2, int16(-2),
+	uint16(5), ParameterI4, int32(-4),
+	uint16(6), ParameterI, int32(-5),
+	uint16(7), ParameterUI1, uint8(1),
+	uint16(8), ParameterUI2, uint16(2),
+	uint16(9), ParameterUI4, uint32(4),
+	uint16(10), ParameterUI, uint32(5),
+	uint16(0x4000), ParameterSetType,
+	// The nested set.
+	uint16(0x22), int16(1),
+	uint16(1), ParameterBSTR, bstr("nested"),
+	uint16(12), ParameterArray, int16(2),
+	// The sets of the array.
+	uint16(0x23), int16(1), uint16(1), ParameterUI2, uint16(10),
+	uint16(0x23), int16(1), uint16(1), ParameterUI2, uint16(20),
+	uint16(13), ParameterBinData, uint16(3),
+	uint16(14), ParameterBSTR, bstr("용지 𝄞"),
+)
+
+// TestParameterSet decodes every type of parameter item.
+//
+// TestParameterSet은 모든 형식의 파라미터 아이템을 읽습니다.
+func TestParameterSet(t *testing.T) {
+	v, err := DecodeRecord(&Record{TagID: TagDocData, Data: parameterSetData},
+		FileVersion{5, 0, 4, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps := v.(*ParameterSet)
+	if ps.ID != 0x21 || len(ps.Items) != 14 {
+		t.Fatalf("unexpected set %#x with %d items", ps.ID, len(ps.Items))
+	}
+
+	want := map[string]interface{}{
+		"1":          nil,
+		"2":          "A4",
+		"3":          int32(-1),
+		"4":          int32(-2),
+		"5":          int32(-4),
+		"6":          int32(-5),
+		"7":          uint32(1),
+		"8":          uint32(2),
+		"9":          uint32(4),
+		"10":         uint32(5),
+		"0x4000/1":   "nested",
+		"12/0/1":     uint32(10),
+		"12/1/1":     uint32(20),
+		"13":         uint16(3),
+		"14":         "용지 𝄞",
+		"16384/0x01": "nested",
+	}
+	for path, value := range want {
+		item, ok := ps.Get(path)
+		if !ok {
+			t.Errorf("%s: not found", path)
+			continue
+		}
+		if !reflect.DeepEqual(item.Value, value) {
+			t.Errorf("%s: expected %#v, got %#v", path, value, item.Value)
+		}
+	}
+
+	for _, path := range []string{"", "11", "2/1", "12/2/1", "12/x", "0x4000/2"} {
+		if _, ok := ps.Get(path); ok {
+			t.Errorf("%s: expected not found", path)
+		}
+	}
+	if item, ok := ps.Get("12"); !ok || item.Type != ParameterArray {
+		t.Errorf("expected the array, got %+v", item)
+	}
+}
+
+// TestParameterSetJSON dumps a parameter set as JSON.
+//
+// TestParameterSetJSON은 파라미터 셋을 JSON으로 씁니다.
+func TestParameterSetJSON(t *testing.T) {
+	ps := &ParameterSet{ID: 1, Items: []ParameterItem{
+		{ID: 1, Type: ParameterBSTR, Value: "A4"},
+		{ID: 2, Type: ParameterArray, Value: []*ParameterSet{
+			{ID: 2, Items: []ParameterItem{{ID: 1, Type: ParameterNull}}},
+		}},
+	}}
+
+	b, err := json.Marshal(ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":1,"items":[{"id":1,"type":"bstr","value":"A4"},` +
+		`{"id":2,"type":"array","value":[{"id":2,"items":[` +
+		`{"id":1,"type":"null","value":null}]}]}]}`
+	if string(b) != want {
+		t.Errorf("expected %s, got %s", want, b)
+	}
+}
+
+// TestParameterSetErrors checks that unknown types and cut off sets are
+// reported.
+//
+// TestParameterSetErrors는 알 수 없는 형식과 잘린 셋을 오류로 알리는지
+// 확인합니다.
+func TestParameterSetErrors(t *testing.T) {
+	var ps ParameterSet
+	err := ps.deserialize(littleEndian(uint16(1), int16(1), uint16(1),
+		uint16(0x10), uint32(0)))
+	if !errors.Is(err, ErrUnknownParameterType) {
+		t.Errorf("expected ErrUnknownParameterType, got %v", err)
+	}
+
+	for _, n := range []int{3, 30, len(parameterSetData) - 1} {
+		ps = ParameterSet{}
+		err = ps.deserialize(parameterSetData[:n])
+		if !errors.Is(err, ErrTruncatedRecord) {
+			t.Errorf("%d bytes: expected ErrTruncatedRecord, got %v", n, err)
+		}
+	}
+}
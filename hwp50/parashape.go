@@ -0,0 +1,242 @@
+package hwp50
+
+// ParaShape is the layout of a paragraph: alignment, margins, line spacing
+// and how lines and pages break. Paragraphs point at para shapes by their
+// index.
+//
+// ParaShape는 문단의 정렬, 여백, 줄 간격, 줄/쪽 나눔 같은 모양입니다.
+// 문단은 순번으로 문단 모양을 가리킵니다.
+type ParaShape struct {
+	// Implements "Chart 44" in the hwp50 specs
+	Property1 uint32
+
+	// The margins and spacing in HWPUNIT. A negative Indent is an
+	// outdent.
+	LeftMargin  int32
+	RightMargin int32
+	Indent      int32
+	SpaceBefore int32
+	SpaceAfter  int32
+
+	// LineSpacing is in percent or in HWPUNIT depending on SpacingType.
+	// Files from 5.0.2.5 on store it again in a newer field, which is
+	// preferred when present.
+	LineSpacing int32
+
+	// SpacingType tells how LineSpacing is measured.
+	SpacingType LineSpacingType
+
+	TabDefID uint16
+
+	// NumberingID is the id of a numbering or of a bullet, depending on
+	// Heading.
+	NumberingID uint16
+
+	BorderFillID uint16
+
+	// BorderOffset is the space between the border and the text, indexed
+	// by BorderLeft, BorderRight, BorderTop and BorderBottom.
+	BorderOffset [4]int16
+
+	// Implements "Chart 45" in the hwp50 specs
+	// Only relevant for hwp 5.0.1.7 and up
+	Property2 uint32
+
+	// Implements "Chart 46" in the hwp50 specs
+	// Only relevant for hwp 5.0.2.5 and up
+	Property3 uint32
+}
+
+// LineSpacingType is how the line spacing of a paragraph is measured.
+//
+// LineSpacingType은 줄 간격을 재는 방식입니다.
+type LineSpacingType uint8
+
+const (
+	// LineSpacingPercent is relative to the size of the characters.
+	LineSpacingPercent LineSpacingType = iota
+
+	// LineSpacingFixed is a fixed height in HWPUNIT.
+	LineSpacingFixed
+
+	// LineSpacingBetweenLines is the space between lines in HWPUNIT.
+	LineSpacingBetweenLines
+
+	// LineSpacingAtLeast is a minimum height in HWPUNIT. Only used from
+	// 5.0.2.5 on.
+	LineSpacingAtLeast
+)
+
+// Alignment is the horizontal alignment of a paragraph.
+//
+// Alignment는 문단의 가로 정렬입니다.
+type Alignment uint8
+
+const (
+	AlignJustify Alignment = iota
+	AlignLeft
+	AlignRight
+	AlignCenter
+	AlignDistribute
+	AlignDivide
+)
+
+// LineBreak is where a word may be split at the end of a line.
+//
+// LineBreak는 줄 끝에서 단어를 나누는 단위입니다.
+type LineBreak uint8
+
+const (
+	LineBreakWord LineBreak = iota
+	LineBreakHyphen
+	LineBreakChar
+)
+
+// VerticalAlign is how characters of different sizes line up.
+//
+// VerticalAlign은 크기가 다른 글자들의 세로 정렬입니다.
+type VerticalAlign uint8
+
+const (
+	VerticalAlignBaseline VerticalAlign = iota
+	VerticalAlignTop
+	VerticalAlignCenter
+	VerticalAlignBottom
+)
+
+// HeadingType is the kind of heading a paragraph is.
+//
+// HeadingType은 문단 머리의 종류입니다.
+type HeadingType uint8
+
+const (
+	HeadingNone HeadingType = iota
+	HeadingOutline
+	HeadingNumbering
+	HeadingBullet
+)
+
+// Alignment returns bits 2~4
+func (ps *ParaShape) Alignment() Alignment {
+	return Alignment(bits32(ps.Property1, 2, 3))
+}
+
+// LatinLineBreak returns bits 5~6
+func (ps *ParaShape) LatinLineBreak() LineBreak {
+	return LineBreak(bits32(ps.Property1, 5, 2))
+}
+
+// KoreanLineBreak returns bit 7, which is either a word or a character.
+func (ps *ParaShape) KoreanLineBreak() LineBreak {
+	if bits32(ps.Property1, 7, 1) != 0 {
+		return LineBreakChar
+	}
+	return LineBreakWord
+}
+
+func (ps *ParaShape) UsesGrid() bool {
+	return bits32(ps.Property1, 8, 1) != 0
+}
+
+// MinSpace returns bits 9~15, the minimum width of a space in percent.
+func (ps *ParaShape) MinSpace() uint8 {
+	return uint8(bits32(ps.Property1, 9, 7))
+}
+
+func (ps *ParaShape) ProtectsWidowOrphan() bool {
+	return bits32(ps.Property1, 16, 1) != 0
+}
+
+func (ps *ParaShape) KeepsWithNext() bool {
+	return bits32(ps.Property1, 17, 1) != 0
+}
+
+func (ps *ParaShape) KeepsLinesTogether() bool {
+	return bits32(ps.Property1, 18, 1) != 0
+}
+
+func (ps *ParaShape) BreaksPageBefore() bool {
+	return bits32(ps.Property1, 19, 1) != 0
+}
+
+// VerticalAlign returns bits 20~21
+func (ps *ParaShape) VerticalAlign() VerticalAlign {
+	return VerticalAlign(bits32(ps.Property1, 20, 2))
+}
+
+func (ps *ParaShape) LineHeightByFont() bool {
+	return bits32(ps.Property1, 22, 1) != 0
+}
+
+// Heading returns bits 23~24
+func (ps *ParaShape) Heading() HeadingType {
+	return HeadingType(bits32(ps.Property1, 23, 2))
+}
+
+// Level returns bits 25~27, the outline level starting at 0.
+func (ps *ParaShape) Level() uint8 {
+	return uint8(bits32(ps.Property1, 25, 3))
+}
+
+func (ps *ParaShape) ConnectsBorder() bool {
+	return bits32(ps.Property1, 28, 1) != 0
+}
+
+func (ps *ParaShape) IgnoresMarginsForBorder() bool {
+	return bits32(ps.Property1, 29, 1) != 0
+}
+
+// SingleLine returns bits 0~1 of Property2.
+func (ps *ParaShape) SingleLine() uint8 {
+	return uint8(bits32(ps.Property2, 0, 2))
+}
+
+func (ps *ParaShape) AutoSpacesKoreanLatin() bool {
+	return bits32(ps.Property2, 4, 1) != 0
+}
+
+func (ps *ParaShape) AutoSpacesKoreanNumber() bool {
+	return bits32(ps.Property2, 5, 1) != 0
+}
+
+func (ps *ParaShape) deserialize(data []byte, ver FileVersion) error {
+	d := newDataReader(data)
+	ps.Property1 = d.uint32()
+	ps.LeftMargin = d.int32()
+	ps.RightMargin = d.int32()
+	ps.Indent = d.int32()
+	ps.SpaceBefore = d.int32()
+	ps.SpaceAfter = d.int32()
+	ps.LineSpacing = d.int32()
+	ps.SpacingType = LineSpacingType(bits32(ps.Property1, 0, 2))
+	ps.TabDefID = d.uint16()
+	ps.NumberingID = d.uint16()
+	ps.BorderFillID = d.uint16()
+	for i := range ps.BorderOffset {
+		ps.BorderOffset[i] = d.int16()
+	}
+
+	if ver.AtLeast(5, 0, 1, 7) && d.remaining() >= 4 {
+		ps.Property2 = d.uint32()
+	}
+	if ver.AtLeast(5, 0, 2, 5) && d.remaining() >= 8 {
+		ps.Property3 = d.uint32()
+		ps.LineSpacing = int32(d.uint32())
+		ps.SpacingType = LineSpacingType(bits32(ps.Property3, 0, 5))
+	}
+
+	return d.err
+}
+
+func decodeParaShape(rec *Record, ver FileVersion) (interface{}, error) {
+	ps := new(ParaShape)
+	err := ps.deserialize(rec.Data, ver)
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func init() {
+	registerDecoder(TagParaShape, decodeParaShape)
+}
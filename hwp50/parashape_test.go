@@ -0,0 +1,104 @@
+package hwp50
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParaShapes checks the para shapes of the testdata file.
+//
+// TestParaShapes는 testdata 파일의 문단 모양을 확인합니다.
+func TestParaShapes(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shapes := hwp.DocInfo.ParagraphShape
+	if len(shapes) != 12 {
+		t.Fatalf("expected 12 para shapes, got %d", len(shapes))
+	}
+
+	ps := shapes[0]
+	if ps.Alignment() != AlignLeft || !ps.UsesGrid() {
+		t.Errorf("unexpected property %#x", ps.Property1)
+	}
+	if ps.SpacingType != LineSpacingPercent || ps.LineSpacing != 130 {
+		t.Errorf("unexpected line spacing %d %d", ps.SpacingType, ps.LineSpacing)
+	}
+	if ps.BorderFillID != 2 || ps.TabDefID != 0 || ps.Heading() != HeadingNone {
+		t.Errorf("unexpected ids %+v", ps)
+	}
+
+	if shapes[1].Indent != -2620 {
+		t.Errorf("expected outdent -2620, got %d", shapes[1].Indent)
+	}
+
+	// Shapes 4 to 10 are the outline levels 7 down to 1.
+	// 4번부터 10번까지는 개요 7수준부터 1수준입니다.
+	for i, ps := range shapes[4:11] {
+		level := uint8(6 - i)
+		if ps.Heading() != HeadingOutline || ps.Level() != level {
+			t.Errorf("shape %d: unexpected heading %d level %d", i+4,
+				ps.Heading(), ps.Level())
+		}
+		if ps.KoreanLineBreak() != LineBreakChar || ps.MinSpace() != 20 {
+			t.Errorf("shape %d: unexpected line break %d or min space %d",
+				i+4, ps.KoreanLineBreak(), ps.MinSpace())
+		}
+		if ps.TabDefID != 1 || ps.LeftMargin != int32(level+1)*2000 {
+			t.Errorf("shape %d: unexpected tab def %d or margin %d", i+4,
+				ps.TabDefID, ps.LeftMargin)
+		}
+	}
+}
+
+// TestParaShapeVersions checks which line spacing field is used.
+//
+// TestParaShapeVersions는 어느 줄 간격 필드를 쓰는지 확인합니다.
+func TestParaShapeVersions(t *testing.T) {
+	data := littleEndian(
+		uint32(LineSpacingFixed)|3<<2|1<<16|1<<17|1<<19|2<<20,
+		[5]int32{1, 2, 3, 4, 5},
+		int32(1000),
+		uint16(1), uint16(2), uint16(3),
+		[4]int16{4, 5, 6, 7},
+		uint32(1<<4),
+		uint32(LineSpacingAtLeast),
+		uint32(2000),
+	)
+
+	var ps ParaShape
+	err := ps.deserialize(data, FileVersion{5, 0, 2, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.SpacingType != LineSpacingFixed || ps.LineSpacing != 1000 {
+		t.Errorf("unexpected line spacing %d %d", ps.SpacingType, ps.LineSpacing)
+	}
+	if !ps.AutoSpacesKoreanLatin() || ps.AutoSpacesKoreanNumber() {
+		t.Errorf("unexpected property2 %#x", ps.Property2)
+	}
+	if ps.Alignment() != AlignCenter || !ps.ProtectsWidowOrphan() ||
+		!ps.KeepsWithNext() || ps.KeepsLinesTogether() ||
+		!ps.BreaksPageBefore() || ps.VerticalAlign() != VerticalAlignCenter {
+		t.Errorf("unexpected property1 %#x", ps.Property1)
+	}
+	if ps.SpaceAfter != 5 || ps.NumberingID != 2 ||
+		ps.BorderOffset[BorderBottom] != 7 {
+		t.Errorf("unexpected fields %+v", ps)
+	}
+
+	err = ps.deserialize(data, FileVersion{5, 0, 2, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.SpacingType != LineSpacingAtLeast || ps.LineSpacing != 2000 {
+		t.Errorf("unexpected line spacing %d %d", ps.SpacingType, ps.LineSpacing)
+	}
+
+	err = ps.deserialize(data[:30], FileVersion{5, 0, 2, 5})
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
@@ -0,0 +1,304 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// Control chars of PARA_TEXT. Chars below 32 are controls and come in three
+// kinds: char controls take a single wchar, inline and extended controls take
+// 8 wchars. Extended controls have a CTRL_HEADER record of their own.
+//
+// PARA_TEXT의 제어 문자들입니다. 32 미만의 문자는 제어 문자이고 세 종류가
+// 있습니다. 문자 컨트롤은 wchar 하나, 인라인과 확장 컨트롤은 wchar 8개를
+// 차지합니다. 확장 컨트롤은 따로 CTRL_HEADER 레코드가 있습니다.
+const (
+	ctrlUnusable       wchar = 0
+	ctrlSectionColumn  wchar = 2
+	ctrlFieldStart     wchar = 3
+	ctrlFieldEnd       wchar = 4
+	ctrlTitleMark      wchar = 8
+	ctrlTab            wchar = 9
+	ctrlLineBreak      wchar = 10
+	ctrlDrawingTable   wchar = 11
+	ctrlParaBreak      wchar = 13
+	ctrlHiddenComment  wchar = 15
+	ctrlHeaderFooter   wchar = 16
+	ctrlFootnote       wchar = 17
+	ctrlAutoNumber     wchar = 18
+	ctrlPageControl    wchar = 21
+	ctrlBookmark       wchar = 22
+	ctrlDutmal         wchar = 23
+	ctrlHyphen         wchar = 24
+	ctrlNonBreakingSpc wchar = 30
+	ctrlFixedWidthSpc  wchar = 31
+
+	// ctrlSize is how many wchars inline and extended controls take.
+	ctrlSize = 8
+)
+
+// ctrlKinds tells the kind of every control char.
+var ctrlKinds = [32]RunKind{
+	0: RunCharControl, 1: RunExtendedControl, 2: RunExtendedControl,
+	3: RunExtendedControl, 4: RunInlineControl, 5: RunInlineControl,
+	6: RunInlineControl, 7: RunInlineControl, 8: RunInlineControl,
+	9: RunTab, 10: RunLineBreak, 11: RunExtendedControl,
+	12: RunExtendedControl, 13: RunParaBreak, 14: RunExtendedControl,
+	15: RunExtendedControl, 16: RunExtendedControl, 17: RunExtendedControl,
+	18: RunExtendedControl, 19: RunInlineControl, 20: RunInlineControl,
+	21: RunExtendedControl, 22: RunExtendedControl, 23: RunExtendedControl,
+	24: RunHyphen, 25: RunCharControl, 26: RunCharControl,
+	27: RunCharControl, 28: RunCharControl, 29: RunCharControl,
+	30: RunNonBreakingSpace, 31: RunFixedWidthSpace,
+}
+
+// RunKind tells what a TextRun holds.
+//
+// RunKind는 TextRun이 무엇을 담고 있는지를 뜻합니다.
+type RunKind uint8
+
+const (
+	// RunText is plain text.
+	RunText RunKind = iota
+
+	// RunTab is a tab. It's an inline control in the spec.
+	RunTab
+
+	// RunLineBreak is a forced line break.
+	RunLineBreak
+
+	// RunParaBreak ends the paragraph.
+	RunParaBreak
+
+	// RunHyphen is a hyphen.
+	RunHyphen
+
+	// RunNonBreakingSpace is a space that keeps the words around it on
+	// the same line (묶음 빈칸).
+	RunNonBreakingSpace
+
+	// RunFixedWidthSpace is a space as wide as a digit (고정폭 빈칸).
+	RunFixedWidthSpace
+
+	// RunCharControl is a reserved or unusable char control.
+	RunCharControl
+
+	// RunInlineControl is an inline control such as the end of a field.
+	RunInlineControl
+
+	// RunExtendedControl is a placeholder of a control with its own
+	// CTRL_HEADER, such as a table or a footnote.
+	RunExtendedControl
+)
+
+var runKindNames = [...]string{
+	RunText:             "text",
+	RunTab:              "tab",
+	RunLineBreak:        "line break",
+	RunParaBreak:        "paragraph break",
+	RunHyphen:           "hyphen",
+	RunNonBreakingSpace: "non-breaking space",
+	RunFixedWidthSpace:  "fixed-width space",
+	RunCharControl:      "char control",
+	RunInlineControl:    "inline control",
+	RunExtendedControl:  "extended control",
+}
+
+func (k RunKind) String() string {
+	if int(k) < len(runKindNames) {
+		return runKindNames[k]
+	}
+	return fmt.Sprintf("RunKind(%d)", uint8(k))
+}
+
+// CtrlID is the 4 char id of a control, e.g. 'tbl ' for tables. The first
+// char is in the most significant byte.
+//
+// CtrlID는 컨트롤의 4글자 id입니다. 예: 표는 'tbl '. 첫 글자가 가장 높은
+// 바이트에 있습니다.
+type CtrlID uint32
+
+// Ids of the controls that hold paragraphs of their own.
+//
+// 자신의 문단을 갖는 컨트롤들의 id 입니다.
+const (
+	CtrlIDTable       CtrlID = 't'<<24 | 'b'<<16 | 'l'<<8 | ' '
+	CtrlIDShapeObject CtrlID = 'g'<<24 | 's'<<16 | 'o'<<8 | ' '
+	CtrlIDHeader      CtrlID = 'h'<<24 | 'e'<<16 | 'a'<<8 | 'd'
+	CtrlIDFooter      CtrlID = 'f'<<24 | 'o'<<16 | 'o'<<8 | 't'
+	CtrlIDFootnote    CtrlID = 'f'<<24 | 'n'<<16 | ' '<<8 | ' '
+	CtrlIDEndnote     CtrlID = 'e'<<24 | 'n'<<16 | ' '<<8 | ' '
+)
+
+// CtrlIDSectionDef is the id of the control that starts every section and
+// holds its page and numbering settings.
+//
+// CtrlIDSectionDef는 모든 구역의 처음에 오는, 구역의 쪽과 번호 설정을
+// 담은 컨트롤의 id입니다.
+const CtrlIDSectionDef CtrlID = 's'<<24 | 'e'<<16 | 'c'<<8 | 'd'
+
+// makeCtrlID is MAKE_4CHID of the spec.
+func makeCtrlID(a, b, c, d byte) CtrlID {
+	return CtrlID(a)<<24 | CtrlID(b)<<16 | CtrlID(c)<<8 | CtrlID(d)
+}
+
+func (id CtrlID) String() string {
+	return string([]byte{byte(id >> 24), byte(id >> 16), byte(id >> 8), byte(id)})
+}
+
+// TextRun is a piece of the text of a paragraph.
+//
+// TextRun은 문단 텍스트의 한 조각입니다.
+type TextRun struct {
+	Kind RunKind
+
+	// Text is the text of a RunText. For tabs, line breaks, hyphens and
+	// the special spaces it's the text they stand for.
+	Text string
+
+	// Pos is the position of the run in wchars from the start of the
+	// paragraph. PARA_CHAR_SHAPE and PARA_LINE_SEG use the same unit.
+	Pos uint32
+
+	// Code is the control char of a control run.
+	Code uint16
+
+	// CtrlID is the id of an inline or extended control.
+	CtrlID CtrlID
+
+	// CtrlHeader is the CTRL_HEADER record of an extended control.
+	CtrlHeader *RecordNode
+
+	// Control is CtrlHeader decoded.
+	Control Control
+}
+
+// runText is the text special char controls stand for.
+var runText = map[RunKind]string{
+	RunTab:              "\t",
+	RunLineBreak:        "\n",
+	RunHyphen:           "-",
+	RunNonBreakingSpace: "\u00a0",
+	RunFixedWidthSpace:  "\u2007",
+}
+
+// decodeParaText splits the wchars of a PARA_TEXT record into runs.
+func decodeParaText(data []byte) ([]TextRun, error) {
+	d := newDataReader(data)
+	chars := d.wchars(len(data) / 2)
+
+	var (
+		runs  []TextRun
+		plain []wchar
+		start uint32
+	)
+	flush := func() {
+		if len(plain) == 0 {
+			return
+		}
+		runs = append(runs, TextRun{
+			Kind: RunText,
+			Text: wcharsToString(plain),
+			Pos:  start,
+		})
+		plain = plain[:0]
+	}
+
+	for i := 0; i < len(chars); {
+		ch := chars[i]
+		if ch >= 32 {
+			if len(plain) == 0 {
+				start = uint32(i)
+			}
+			plain = append(plain, ch)
+			i++
+			continue
+		}
+		flush()
+
+		kind := ctrlKinds[ch]
+		run := TextRun{
+			Kind: kind,
+			Text: runText[kind],
+			Pos:  uint32(i),
+			Code: uint16(ch),
+		}
+
+		size := 1
+		if kind == RunTab || kind == RunInlineControl || kind == RunExtendedControl {
+			size = ctrlSize
+			if len(chars)-i < size {
+				return nil, fmt.Errorf("%w: control char %d at %d "+
+					"needs %d wchars, have %d", ErrTruncatedRecord,
+					ch, i, size, len(chars)-i)
+			}
+			// The control id is the 4 bytes after the char.
+			// 컨트롤 id는 제어 문자 다음 4바이트입니다.
+			id := binary.LittleEndian.Uint32(data[(i+1)*2:])
+			if kind != RunTab {
+				run.CtrlID = CtrlID(id)
+			}
+		}
+
+		runs = append(runs, run)
+		i += size
+	}
+	flush()
+
+	return runs, nil
+}
+
+func init() {
+	registerDecoder(TagParaText, func(rec *Record, ver FileVersion) (interface{}, error) {
+		return decodeParaText(rec.Data)
+	})
+}
+
+// linkControls points the extended control runs at the CTRL_HEADER records
+// of the paragraph and decodes them. They come in the same order. A control
+// that can't be decoded is kept as an *UnknownControl holding the error, so
+// the rest of the document can still be read.
+func linkControls(runs []TextRun, ctrls []*RecordNode, ver FileVersion) {
+	var i int
+	for r := range runs {
+		if runs[r].Kind != RunExtendedControl {
+			continue
+		}
+		if i >= len(ctrls) {
+			return
+		}
+		c, err := DecodeControl(ctrls[i], ver)
+		if err != nil {
+			c = &UnknownControl{
+				ID:   runs[r].CtrlID,
+				Data: append([]byte(nil), ctrlPayload(ctrls[i].Data)...),
+				Err:  err,
+			}
+		}
+		runs[r].CtrlHeader = ctrls[i]
+		runs[r].Control = c
+		i++
+	}
+}
+
+// ctrlPayload returns the data of a CTRL_HEADER past its ctrl id.
+func ctrlPayload(data []byte) []byte {
+	if len(data) < 4 {
+		return nil
+	}
+	return data[4:]
+}
+
+// Text returns the text of the paragraph without its controls. Tabs, line
+// breaks, hyphens and special spaces are kept as the chars they stand for.
+//
+// Text는 컨트롤을 뺀 문단의 텍스트를 반환합니다. 탭, 줄 바꿈, 하이픈,
+// 특수 빈칸은 해당하는 문자로 남습니다.
+func (p *Paragraph) Text() string {
+	var b strings.Builder
+	for _, run := range p.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
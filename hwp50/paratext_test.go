@@ -0,0 +1,111 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+)
+
+// paraText encodes units into the data of a PARA_TEXT record.
+func paraText(units ...uint16) []byte {
+	b := make([]byte, len(units)*2)
+	for i, u := range units {
+		binary.LittleEndian.PutUint16(b[i*2:], u)
+	}
+	return b
+}
+
+// control returns the 8 wchars of an inline or extended control.
+func control(ch uint16, id CtrlID) []uint16 {
+	return []uint16{ch, uint16(id), uint16(id >> 16), 0, 0, 0, 0, ch}
+}
+
+// TestDecodeParaText decodes text mixed with every kind of control.
+//
+// TestDecodeParaText는 모든 종류의 컨트롤이 섞인 텍스트를 decode 합니다.
+func TestDecodeParaText(t *testing.T) {
+	var units []uint16
+	units = append(units, utf16.Encode([]rune("가a😀"))...)
+	units = append(units, control(9, 0)...)
+	units = append(units, 'b', 24, 'c', 30, 31, 10)
+	units = append(units, control(11, makeCtrlID('t', 'b', 'l', ' '))...)
+	units = append(units, control(4, makeCtrlID('%', 'h', 'l', 'k'))...)
+	units = append(units, 13)
+
+	runs, err := decodeParaText(paraText(units...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		kind RunKind
+		text string
+		pos  uint32
+		id   string
+	}{
+		{RunText, "가a😀", 0, ""},
+		{RunTab, "\t", 4, ""},
+		{RunText, "b", 12, ""},
+		{RunHyphen, "-", 13, ""},
+		{RunText, "c", 14, ""},
+		{RunNonBreakingSpace, "\u00a0", 15, ""},
+		{RunFixedWidthSpace, "\u2007", 16, ""},
+		{RunLineBreak, "\n", 17, ""},
+		{RunExtendedControl, "", 18, "tbl "},
+		{RunInlineControl, "", 26, "%hlk"},
+		{RunParaBreak, "", 34, ""},
+	}
+	if len(runs) != len(want) {
+		t.Fatalf("expected %d runs, got %d: %+v", len(want), len(runs), runs)
+	}
+	for i, w := range want {
+		r := runs[i]
+		if r.Kind != w.kind || r.Text != w.text || r.Pos != w.pos {
+			t.Errorf("run %d: expected %v %q at %d, got %v %q at %d",
+				i, w.kind, w.text, w.pos, r.Kind, r.Text, r.Pos)
+		}
+		if w.id != "" && r.CtrlID.String() != w.id {
+			t.Errorf("run %d: expected ctrl id %q, got %q", i, w.id, r.CtrlID)
+		}
+	}
+
+	_, err = decodeParaText(paraText(control(11, 0)[:5]...))
+	if err == nil {
+		t.Error("expected an error for a cut control")
+	}
+}
+
+// TestParagraphRunsTestdata checks that the controls of the testdata
+// paragraph are linked to their CTRL_HEADER records.
+//
+// TestParagraphRunsTestdata는 testdata 문단의 컨트롤들이 CTRL_HEADER
+// 레코드에 연결되었는지 확인합니다.
+func TestParagraphRunsTestdata(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := hwp.BodyText[0].Paragraphs[0]
+	if p.Text() != "test" {
+		t.Errorf("expected text %q, got %q", "test", p.Text())
+	}
+
+	var ids []string
+	for _, run := range p.Runs {
+		if run.Kind != RunExtendedControl {
+			continue
+		}
+		if run.CtrlHeader == nil {
+			t.Fatalf("control %q isn't linked", run.CtrlID)
+		}
+		header := CtrlID(binary.LittleEndian.Uint32(run.CtrlHeader.Data))
+		if header != run.CtrlID {
+			t.Errorf("control %q linked to %q", run.CtrlID, header)
+		}
+		ids = append(ids, run.CtrlID.String())
+	}
+	if len(ids) != 2 || ids[0] != "secd" || ids[1] != "cold" {
+		t.Errorf("unexpected controls %q", ids)
+	}
+}
@@ -0,0 +1,218 @@
+package hwp50
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// TagID is the 10 bit id that tells what kind of data a record holds.
+//
+// TagID는 레코드가 어떤 데이터를 담고 있는지 알려주는 10비트 id입니다.
+type TagID uint16
+
+// Record is the unit DocInfo and BodyText streams are made of. Every record
+// starts with a 32 bit header packing the tag id, the level and the size of
+// the data that follows.
+//
+//	bits 0-9:   TagID
+//	bits 10-19: Level
+//	bits 20-31: Size. 0xFFF means the size is in the next DWORD
+//
+// Record는 DocInfo와 BodyText 스트림을 이루는 단위입니다. 모든 레코드는
+// tag id, level, 데이터 크기를 담은 32비트 헤더로 시작합니다.
+type Record struct {
+	// TagID tells what kind of data the record holds.
+	TagID TagID
+
+	// Level is the depth of the record. A record belongs to the closest
+	// previous record with a smaller level.
+	Level uint16
+
+	// Size is the size of Data in bytes.
+	Size uint32
+
+	// Data is the payload of the record.
+	Data []byte
+
+	// Offset is where the record header starts within the stream.
+	Offset int64
+}
+
+// Masks and shifts of the record header.
+const (
+	recordTagMask    = 0x3FF
+	recordLevelShift = 10
+	recordLevelMask  = 0x3FF
+	recordSizeShift  = 20
+
+	// recordExtendedSize marks that the size doesn't fit into 12 bits and
+	// follows the header as a DWORD.
+	recordExtendedSize = 0xFFF
+)
+
+// ErrTruncatedRecord is returned when a stream ends in the middle of a
+// record.
+//
+// ErrTruncatedRecord는 레코드 중간에 스트림이 끝났을 때 반환됩니다.
+var ErrTruncatedRecord = errors.New("truncated record 레코드가 잘렸습니다")
+
+// RecordError records an error and the offset of the record that caused it.
+//
+// RecordError는 에러와 에러가 난 레코드의 위치를 담고 있습니다.
+type RecordError struct {
+	// Offset is where the record header starts within the stream.
+	Offset int64
+
+	// TagID is the tag of the record if its header could be read.
+	TagID TagID
+
+	// Want is how many bytes of the record were expected and Got how
+	// many were read before the stream ended.
+	Want, Got int64
+
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	if e.Want == 0 {
+		return fmt.Sprintf("record %v at offset %d: %v",
+			e.TagID, e.Offset, e.Err)
+	}
+	return fmt.Sprintf("record %v at offset %d: %v (want %d bytes, got %d)",
+		e.TagID, e.Offset, e.Err, e.Want, e.Got)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// RecordReader reads records one by one from a decompressed DocInfo or
+// BodyText stream.
+//
+// RecordReader는 압축이 풀린 DocInfo나 BodyText 스트림에서 레코드를 하나씩
+// 읽습니다.
+type RecordReader struct {
+	r      io.Reader
+	offset int64
+}
+
+// NewRecordReader returns a RecordReader reading from r.
+//
+// NewRecordReader는 r에서 읽는 RecordReader를 반환합니다.
+func NewRecordReader(r io.Reader) *RecordReader {
+	return &RecordReader{r: r}
+}
+
+// Next reads the next record. It returns io.EOF when the stream ends right
+// after a record and a *RecordError wrapping ErrTruncatedRecord when it ends
+// in the middle of one.
+//
+// Next는 다음 레코드를 읽습니다. 레코드가 끝난 자리에서 스트림이 끝나면
+// io.EOF를, 레코드 중간에 끝나면 ErrTruncatedRecord를 담은 *RecordError를
+// 반환합니다.
+func (rr *RecordReader) Next() (*Record, error) {
+	start := rr.offset
+
+	var header [4]byte
+	err := rr.read(header[:])
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, rr.error(start, 0, 4, err)
+	}
+
+	h := binary.LittleEndian.Uint32(header[:])
+	rec := &Record{
+		TagID:  TagID(h & recordTagMask),
+		Level:  uint16((h >> recordLevelShift) & recordLevelMask),
+		Size:   h >> recordSizeShift,
+		Offset: start,
+	}
+
+	headerLen := int64(4)
+	if rec.Size == recordExtendedSize {
+		var size [4]byte
+		err = rr.read(size[:])
+		if err != nil {
+			return nil, rr.error(start, rec.TagID, 8, err)
+		}
+		rec.Size = binary.LittleEndian.Uint32(size[:])
+		headerLen += 4
+	}
+
+	rec.Data, err = rr.readData(rec.Size)
+	if err != nil {
+		return nil, rr.error(start, rec.TagID, headerLen+int64(rec.Size), err)
+	}
+
+	return rec, nil
+}
+
+// recordPrealloc caps what is allocated for the data of a record before it
+// is read. Bigger records grow as their data arrives, so a size that the
+// stream doesn't back can't make Next allocate up to 4 GiB.
+const recordPrealloc = 1 << 16
+
+// readData reads the size bytes of data of a record.
+func (rr *RecordReader) readData(size uint32) ([]byte, error) {
+	if size <= recordPrealloc {
+		b := make([]byte, size)
+		return b, rr.read(b)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(recordPrealloc)
+	n, err := io.CopyN(&buf, rr.r, int64(size))
+	rr.offset += n
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// read fills b and keeps track of the offset. It returns io.EOF only if
+// nothing was read.
+func (rr *RecordReader) read(b []byte) error {
+	n, err := io.ReadFull(rr.r, b)
+	rr.offset += int64(n)
+	return err
+}
+
+// error wraps err into a *RecordError. want is how many bytes of the record
+// starting at start were expected so far.
+func (rr *RecordReader) error(start int64, tag TagID, want int64, err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = ErrTruncatedRecord
+	}
+
+	return &RecordError{
+		Offset: start,
+		TagID:  tag,
+		Want:   want,
+		Got:    rr.offset - start,
+		Err:    err,
+	}
+}
+
+// ReadRecords reads every record from r.
+//
+// ReadRecords는 r의 모든 레코드를 읽습니다.
+func ReadRecords(r io.Reader) ([]*Record, error) {
+	rr := NewRecordReader(r)
+
+	var records []*Record
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, rec)
+	}
+}
@@ -0,0 +1,149 @@
+package hwp50
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+)
+
+// recordHeader packs a record header the way hwp stores it.
+func recordHeader(tag TagID, level uint16, size uint32) []byte {
+	var b []byte
+	if size >= recordExtendedSize {
+		b = make([]byte, 8)
+		binary.LittleEndian.PutUint32(b[4:], size)
+		size = recordExtendedSize
+	} else {
+		b = make([]byte, 4)
+	}
+	h := uint32(tag) | uint32(level)<<recordLevelShift | size<<recordSizeShift
+	binary.LittleEndian.PutUint32(b, h)
+	return b
+}
+
+// TestRecordReader reads hand made records including one with an extended
+// size.
+//
+// TestRecordReader는 직접 만든 레코드들을 읽습니다. 확장 크기를 쓰는
+// 레코드도 포함합니다.
+func TestRecordReader(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(recordHeader(TagParaHeader, 0, 3))
+	buf.Write([]byte{1, 2, 3})
+	buf.Write(recordHeader(TagParaText, 1, 5000))
+	buf.Write(make([]byte, 5000))
+
+	rr := NewRecordReader(&buf)
+
+	rec, err := rr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.TagID != TagParaHeader || rec.Level != 0 || rec.Size != 3 ||
+		!bytes.Equal(rec.Data, []byte{1, 2, 3}) || rec.Offset != 0 {
+		t.Errorf("unexpected first record %+v", rec)
+	}
+
+	rec, err = rr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.TagID != TagParaText || rec.Level != 1 || rec.Size != 5000 ||
+		len(rec.Data) != 5000 || rec.Offset != 7 {
+		t.Errorf("unexpected second record %v %v %v %v", rec.TagID,
+			rec.Level, rec.Size, rec.Offset)
+	}
+
+	_, err = rr.Next()
+	if err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+}
+
+// TestRecordReaderTruncated checks that a record cut short reports where it
+// started.
+//
+// TestRecordReaderTruncated는 잘린 레코드가 시작 위치를 알려주는지
+// 확인합니다.
+func TestRecordReaderTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(recordHeader(TagParaHeader, 0, 2))
+	buf.Write([]byte{1, 2})
+	buf.Write(recordHeader(TagParaText, 1, 10))
+	buf.Write([]byte{1, 2, 3})
+
+	_, err := ReadRecords(&buf)
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Fatalf("expected ErrTruncatedRecord, got %v", err)
+	}
+
+	var recErr *RecordError
+	if !errors.As(err, &recErr) {
+		t.Fatalf("expected *RecordError, got %T", err)
+	}
+	if recErr.Offset != 6 || recErr.TagID != TagParaText ||
+		recErr.Want != 14 || recErr.Got != 7 {
+		t.Errorf("unexpected error %+v", recErr)
+	}
+}
+
+// TestRecordReaderHugeSize checks that a record declaring more data than
+// the stream holds is reported as truncated, and that big records are
+// still read whole.
+//
+// TestRecordReaderHugeSize는 스트림보다 큰 크기를 선언한 레코드를 잘린
+// 레코드로 알리는지와 큰 레코드도 온전히 읽는지 확인합니다.
+func TestRecordReaderHugeSize(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(recordHeader(TagParaText, 0, 70000))
+	buf.Write(bytes.Repeat([]byte{7}, 70000))
+	buf.Write(recordHeader(TagParaText, 0, 0xFFFFFFFF))
+	buf.Write([]byte{1, 2, 3})
+
+	rr := NewRecordReader(&buf)
+	rec, err := rr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Data) != 70000 || rec.Data[69999] != 7 {
+		t.Errorf("unexpected record of %d bytes", len(rec.Data))
+	}
+
+	_, err = rr.Next()
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Fatalf("expected ErrTruncatedRecord, got %v", err)
+	}
+	var recErr *RecordError
+	if !errors.As(err, &recErr) {
+		t.Fatalf("expected *RecordError, got %T", err)
+	}
+	if recErr.Offset != 70008 || recErr.Want != 8+0xFFFFFFFF ||
+		recErr.Got != 11 {
+		t.Errorf("unexpected error %+v", recErr)
+	}
+}
+
+// TestReadRecordsTestdata reads the records of the testdata streams.
+//
+// TestReadRecordsTestdata는 testdata 스트림들의 레코드를 읽습니다.
+func TestReadRecordsTestdata(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// DocInfo starts with DOCUMENT_PROPERTIES and ID_MAPPINGS and each
+	// section with a PARA_HEADER.
+	if len(hwp.docInfoRecords) < 2 || hwp.docInfoRecords[0].TagID != TagDocumentProperties ||
+		hwp.docInfoRecords[1].TagID != TagIDMappings {
+		t.Errorf("unexpected DocInfo records")
+	}
+
+	for i, section := range hwp.BodyText {
+		if len(section.nodes) == 0 || section.nodes[0].TagID != TagParaHeader {
+			t.Errorf("section %d doesn't start with a PARA_HEADER", i)
+		}
+	}
+}
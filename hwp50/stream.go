@@ -0,0 +1,97 @@
+package hwp50
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+)
+
+// ErrStreamNotFound is returned when a stream asked for isn't in the
+// compound file.
+//
+// ErrStreamNotFound는 찾는 스트림이 복합 파일에 없을 때 반환됩니다.
+var ErrStreamNotFound = errors.New("stream not found 스트림이 없습니다")
+
+// OpenStream opens the stream at the given slash separated path (e.g.
+// "DocInfo" or "BodyText/Section0") and returns a reader of its content.
+// DocInfo and BodyText streams are inflated when the FileHeader says the
+// file is compressed. BinData streams follow the compression flags of their
+// BinData, like OpenBinDataStream.
+//
+// OpenStream은 주어진 경로(예: "DocInfo", "BodyText/Section0")의 스트림을
+// 엽니다. FileHeader에 압축 표시가 있으면 DocInfo, BodyText 스트림의
+// 압축을 풀어서 돌려줍니다. BinData 스트림은 OpenBinDataStream처럼
+// 해당 BinData의 압축 속성을 따릅니다.
+func (hwp *Hwp) OpenStream(name string) (io.ReadCloser, error) {
+	return hwp.openStream(name, hwp.isCompressedStream(name))
+}
+
+// OpenBinDataStream opens the stream of the BinData storage with the given
+// name. Whether it's inflated is decided by the compression flags of bd,
+// falling back to the FileHeader when bd uses the default storage mode.
+//
+// OpenBinDataStream은 BinData 스토리지에서 주어진 이름의 스트림을 엽니다.
+// 압축 여부는 bd의 압축 속성을 따르며, 기본 모드일 때는 FileHeader를
+// 따릅니다.
+func (hwp *Hwp) OpenBinDataStream(bd *BinData, name string) (io.ReadCloser, error) {
+	return hwp.openStream(path.Join(storageBinData, name),
+		bd.IsCompressed(&hwp.FileHeader))
+}
+
+// readStream reads the whole content of the stream at name.
+func (hwp *Hwp) readStream(name string) ([]byte, error) {
+	rc, err := hwp.OpenStream(name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return data, nil
+}
+
+// openStream returns a reader of the stream at name, inflating it if
+// compressed is set.
+func (hwp *Hwp) openStream(name string, compressed bool) (io.ReadCloser, error) {
+	data, ok := hwp.streams[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrStreamNotFound)
+	}
+
+	if !compressed {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	// The streams are raw deflate without the zlib header.
+	// 스트림은 zlib 헤더가 없는 raw deflate 입니다.
+	return flate.NewReader(bytes.NewReader(data)), nil
+}
+
+// isCompressedStream reports if the stream at name is compressed. BinData
+// streams follow their BinData and the others the FileHeader.
+func (hwp *Hwp) isCompressedStream(name string) bool {
+	dir, file := path.Split(name)
+	if dir == storageBinData+"/" {
+		if bd := hwp.binDataOfStream(file); bd != nil {
+			return bd.IsCompressed(&hwp.FileHeader)
+		}
+	}
+
+	if !hwp.FileHeader.Fp.IsCompressed() {
+		return false
+	}
+
+	switch dir {
+	case "":
+		return name == streamDocInfo
+	case storageBodyText + "/", storageBinData + "/":
+		return true
+	}
+	return false
+}
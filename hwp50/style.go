@@ -0,0 +1,173 @@
+package hwp50
+
+import (
+	"errors"
+	"fmt"
+)
+
+// StyleKind tells if a style applies to whole paragraphs or to characters.
+//
+// StyleKind는 스타일이 문단 스타일인지 글자 스타일인지를 뜻합니다.
+type StyleKind uint8
+
+const (
+	StyleParagraph StyleKind = iota
+	StyleCharacter
+)
+
+// Style is a named pair of a para shape and a char shape, like "바탕글" or
+// "개요 1". Paragraphs point at styles by their index.
+//
+// Style은 "바탕글", "개요 1" 같이 이름이 붙은 문단 모양과 글자 모양의
+// 묶음입니다. 문단은 순번으로 스타일을 가리킵니다.
+type Style struct {
+	// Name is the local name of the style.
+	Name string
+
+	// EnglishName is the name of the style in English.
+	EnglishName string
+
+	// Property holds the StyleKind in bits 0~2.
+	Property uint8
+
+	// NextStyleID is the style of the paragraph that follows when Enter
+	// is pressed.
+	NextStyleID uint8
+
+	// LangID is the language of the style as a Windows LCID.
+	LangID int16
+
+	ParaShapeID uint16
+
+	CharShapeID uint16
+}
+
+// Kind returns bits 0~2
+func (s *Style) Kind() StyleKind {
+	return StyleKind(s.Property & 7)
+}
+
+func (s *Style) deserialize(data []byte) error {
+	d := newDataReader(data)
+	s.Name = d.string()
+	s.EnglishName = d.string()
+	s.Property = d.uint8()
+	s.NextStyleID = d.uint8()
+	s.LangID = d.int16()
+	s.ParaShapeID = d.uint16()
+	s.CharShapeID = d.uint16()
+
+	// Files usually end the record with 2 more bytes which the spec
+	// doesn't describe. They're ignored.
+	return d.err
+}
+
+func decodeStyle(rec *Record, ver FileVersion) (interface{}, error) {
+	s := new(Style)
+	err := s.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func init() {
+	registerDecoder(TagStyle, decodeStyle)
+}
+
+// ErrInvalidID is returned when a record points at a DocInfo entry that
+// doesn't exist.
+//
+// ErrInvalidID는 레코드가 없는 DocInfo 항목을 가리킬 때 반환됩니다.
+var ErrInvalidID = errors.New("invalid id 없는 id입니다")
+
+// CharShapeRange is a range of chars of a paragraph sharing a char shape.
+// Start and End count WCHARs like TextRun.Pos.
+//
+// CharShapeRange는 같은 글자 모양을 쓰는 문단의 글자 범위입니다.
+type CharShapeRange struct {
+	Start, End uint32
+
+	CharShapeID uint32
+	CharShape   *CharShape
+}
+
+// Format is the effective formatting of a paragraph.
+//
+// Format은 문단에 실제로 적용되는 모양입니다.
+type Format struct {
+	// Style is the style of the paragraph.
+	Style *Style
+
+	// ParaShape is the paragraph's own para shape, which overrides the
+	// one of Style.
+	ParaShape *ParaShape
+
+	// CharShapes covers the paragraph's chars in order. Paragraphs without
+	// char shapes of their own use the one of Style.
+	CharShapes []CharShapeRange
+}
+
+// CharShapeAt returns the char shape of the char at pos.
+//
+// CharShapeAt은 pos 위치 글자의 글자 모양을 반환합니다.
+func (f *Format) CharShapeAt(pos uint32) *CharShape {
+	for _, r := range f.CharShapes {
+		if pos >= r.Start && pos < r.End {
+			return r.CharShape
+		}
+	}
+	return nil
+}
+
+// Format resolves the style, para shape and char shapes of p.
+//
+// Format은 p의 스타일, 문단 모양, 글자 모양을 찾아 반환합니다.
+func (di *DocInfo) Format(p *Paragraph) (*Format, error) {
+	id := p.Header.ParaStyleID
+	if int(id) >= len(di.Style) {
+		return nil, fmt.Errorf("%w: style %d", ErrInvalidID, id)
+	}
+	f := &Format{Style: di.Style[id]}
+
+	psID := p.Header.ParaShapeID
+	if int(psID) >= len(di.ParagraphShape) {
+		return nil, fmt.Errorf("%w: para shape %d", ErrInvalidID, psID)
+	}
+	f.ParaShape = di.ParagraphShape[psID]
+
+	shapes := p.CharShapes
+	if len(shapes) == 0 {
+		shapes = []ParaCharShape{{ShapeID: uint32(f.Style.CharShapeID)}}
+	}
+	for i, s := range shapes {
+		if int(s.ShapeID) >= len(di.CharShape) {
+			return nil, fmt.Errorf("%w: char shape %d", ErrInvalidID, s.ShapeID)
+		}
+
+		end := p.Header.NChars
+		if i+1 < len(shapes) {
+			end = shapes[i+1].Pos
+		}
+		f.CharShapes = append(f.CharShapes, CharShapeRange{
+			Start:       s.Pos,
+			End:         end,
+			CharShapeID: s.ShapeID,
+			CharShape:   di.CharShape[s.ShapeID],
+		})
+	}
+
+	return f, nil
+}
+
+// StyleByName returns the style with the given local or English name.
+//
+// StyleByName은 주어진 이름이나 영문 이름의 스타일을 반환합니다.
+func (di *DocInfo) StyleByName(name string) (*Style, bool) {
+	for _, s := range di.Style {
+		if s.Name == name || s.EnglishName == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
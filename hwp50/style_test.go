@@ -0,0 +1,129 @@
+package hwp50
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestStyles checks the styles of the testdata file.
+//
+// TestStyles는 testdata 파일의 스타일을 확인합니다.
+func TestStyles(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	styles := hwp.DocInfo.Style
+	if len(styles) != 14 {
+		t.Fatalf("expected 14 styles, got %d", len(styles))
+	}
+
+	want := Style{Name: "바탕글", EnglishName: "Normal", LangID: 0x412,
+		ParaShapeID: 3}
+	if *styles[0] != want {
+		t.Errorf("expected %+v, got %+v", want, *styles[0])
+	}
+	if styles[0].Kind() != StyleParagraph || styles[1].NextStyleID != 1 {
+		t.Errorf("unexpected kind %d or next style %d", styles[0].Kind(),
+			styles[1].NextStyleID)
+	}
+
+	s, ok := hwp.DocInfo.StyleByName("Outline 1")
+	if !ok || s.Name != "개요 1" {
+		t.Fatalf("unexpected style %v", s)
+	}
+	ps := hwp.DocInfo.ParagraphShape[s.ParaShapeID]
+	if ps.Heading() != HeadingOutline || ps.Level() != 0 {
+		t.Errorf("unexpected heading %d level %d", ps.Heading(), ps.Level())
+	}
+	if _, ok := hwp.DocInfo.StyleByName("없는 스타일"); ok {
+		t.Error("expected no style")
+	}
+}
+
+// TestFormat resolves the formatting of paragraphs.
+//
+// TestFormat은 문단의 모양을 찾습니다.
+func TestFormat(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	di := &hwp.DocInfo
+
+	p := hwp.BodyText[0].Paragraphs[0]
+	f, err := di.Format(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Style.Name != "바탕글" || f.ParaShape != di.ParagraphShape[3] {
+		t.Errorf("unexpected style %q or para shape", f.Style.Name)
+	}
+	if len(f.CharShapes) != 1 || f.CharShapes[0].End != 21 ||
+		f.CharShapeAt(20) != di.CharShape[0] || f.CharShapeAt(21) != nil {
+		t.Errorf("unexpected char shapes %+v", f.CharShapes)
+	}
+
+	// A paragraph of style "개요 1" with its own para shape and two runs.
+	// 자기 문단 모양과 두 개의 글자 모양을 가진 "개요 1" 문단입니다.
+	p = &Paragraph{
+		Header: ParaHeader{NChars: 10, ParaShapeID: 1, ParaStyleID: 2},
+		CharShapes: []ParaCharShape{
+			{Pos: 0, ShapeID: 1},
+			{Pos: 4, ShapeID: 3},
+		},
+	}
+	f, err = di.Format(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Style.EnglishName != "Outline 1" || f.ParaShape != di.ParagraphShape[1] {
+		t.Errorf("unexpected style %q or para shape", f.Style.EnglishName)
+	}
+	if f.CharShapeAt(3) != di.CharShape[1] || f.CharShapeAt(4) != di.CharShape[3] ||
+		f.CharShapes[1].End != 10 {
+		t.Errorf("unexpected char shapes %+v", f.CharShapes)
+	}
+
+	// Without char shapes of its own the style's is used.
+	// 글자 모양이 없으면 스타일의 글자 모양을 씁니다.
+	p.CharShapes = nil
+	p.Header.ParaStyleID = 0
+	f, err = di.Format(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.CharShapeAt(0) != di.CharShape[di.Style[0].CharShapeID] {
+		t.Errorf("unexpected char shapes %+v", f.CharShapes)
+	}
+
+	p.CharShapes = []ParaCharShape{{ShapeID: 5}}
+	_, err = di.Format(p)
+	if !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+}
+
+// TestDecodeParaCharShape checks that a cut off pair is reported.
+//
+// TestDecodeParaCharShape는 잘린 쌍을 알리는지 확인합니다.
+func TestDecodeParaCharShape(t *testing.T) {
+	rec := &Record{TagID: TagParaCharShape,
+		Data: littleEndian([]uint32{0, 1, 5, 2})}
+	v, err := DecodeRecord(rec, FileVersion{5, 0, 4, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ParaCharShape{{0, 1}, {5, 2}}
+	if got := v.([]ParaCharShape); len(got) != 2 || got[0] != want[0] ||
+		got[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	rec.Data = rec.Data[:12]
+	_, err = DecodeRecord(rec, FileVersion{5, 0, 4, 0})
+	if !errors.Is(err, ErrTruncatedRecord) {
+		t.Errorf("expected ErrTruncatedRecord, got %v", err)
+	}
+}
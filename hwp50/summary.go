@@ -0,0 +1,125 @@
+package hwp50
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FileHeaderSummary is a readable report of a FileHeader.
+//
+// FileHeaderSummary는 FileHeader를 읽기 쉽게 정리한 것입니다.
+type FileHeaderSummary struct {
+	// Version is the file version as 5.x.y.z
+	Version string `json:"version"`
+
+	// Flags are the names of the FirstProperty flags that are set.
+	Flags []string `json:"flags"`
+
+	// Encrypted tells that the document is encrypted.
+	Encrypted bool `json:"encrypted"`
+
+	// Encryption is the name of the encryption scheme the file uses or
+	// would use when encrypted.
+	Encryption string `json:"encryption"`
+
+	// License is "CCL", "KOGL" or "" when the file has none.
+	License string `json:"license,omitempty"`
+
+	// KOGLCountry is the country of a KOGL license.
+	KOGLCountry string `json:"koglCountry,omitempty"`
+
+	// CopyProtected tells that the file may not be copied.
+	CopyProtected bool `json:"copyProtected"`
+
+	// CopyWithoutModification tells that the file may only be copied
+	// without modifications.
+	CopyWithoutModification bool `json:"copyWithoutModification"`
+}
+
+// encryptionSchemes names the values of FileHeader.EncryptVersion.
+var encryptionSchemes = []string{
+	0: "none",
+	1: "Hancom 2.5 and below",
+	2: "Hancom 3.0 enhanced",
+	3: "Hancom 3.0 old",
+	4: "Hancom 7.0 and newer",
+}
+
+// koglCountries names the values of FileHeader.KOGLCountry.
+var koglCountries = map[uint8]string{
+	6:  "KR",
+	15: "US",
+}
+
+// EncryptionScheme returns the name of the encryption scheme in
+// EncryptVersion.
+//
+// EncryptionScheme은 EncryptVersion의 암호화 방식 이름을 반환합니다.
+func (fh *FileHeader) EncryptionScheme() string {
+	if int(fh.EncryptVersion) < len(encryptionSchemes) {
+		return encryptionSchemes[fh.EncryptVersion]
+	}
+	return fmt.Sprintf("unknown (%d)", fh.EncryptVersion)
+}
+
+// Summary returns a readable report of the header.
+//
+// Summary는 헤더를 읽기 쉽게 정리해서 반환합니다.
+func (fh *FileHeader) Summary() FileHeaderSummary {
+	s := FileHeaderSummary{
+		Version:                 fh.Version.String(),
+		Flags:                   fh.Fp.Flags(),
+		Encrypted:               fh.Fp.IsEncrypted(),
+		Encryption:              fh.EncryptionScheme(),
+		CopyProtected:           fh.Sp.IsCopyProtected(),
+		CopyWithoutModification: fh.Sp.IsAllowedToCopyWithoutModification(),
+	}
+
+	switch {
+	case fh.Fp.HasKOGLLicense():
+		s.License = "KOGL"
+		s.KOGLCountry = koglCountries[fh.KOGLCountry]
+		if s.KOGLCountry == "" {
+			s.KOGLCountry = fmt.Sprintf("unknown (%d)", fh.KOGLCountry)
+		}
+	case fh.Fp.HasCCL():
+		s.License = "CCL"
+	}
+
+	return s
+}
+
+// String formats the summary as one "Key: value" line per field.
+//
+// String은 필드마다 "Key: value" 한 줄씩 정리합니다.
+func (s FileHeaderSummary) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Version:\t%s\n", s.Version)
+	fmt.Fprintf(&b, "Flags:\t\t%s\n", strings.Join(s.Flags, ", "))
+	if s.Encrypted {
+		fmt.Fprintf(&b, "Encryption:\t%s\n", s.Encryption)
+	} else {
+		fmt.Fprintf(&b, "Encryption:\tnot encrypted (%s)\n", s.Encryption)
+	}
+
+	license := s.License
+	switch {
+	case license == "":
+		license = "none"
+	case s.KOGLCountry != "":
+		license += " (" + s.KOGLCountry + ")"
+	}
+	fmt.Fprintf(&b, "License:\t%s\n", license)
+
+	copying := "allowed"
+	switch {
+	case s.CopyProtected:
+		copying = "not allowed"
+	case s.CopyWithoutModification:
+		copying = "allowed without modification"
+	}
+	fmt.Fprintf(&b, "Copying:\t%s\n", copying)
+
+	return b.String()
+}
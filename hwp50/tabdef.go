@@ -0,0 +1,87 @@
+package hwp50
+
+// TabKind is how text lines up at a tab stop.
+//
+// TabKind는 탭 위치에서 글자를 맞추는 방식입니다.
+type TabKind uint8
+
+const (
+	TabLeft TabKind = iota
+	TabRight
+	TabCenter
+	TabDecimal
+)
+
+// TabStop is a tab position of a TabDef.
+//
+// TabStop은 TabDef의 탭 위치 하나입니다.
+type TabStop struct {
+	// Position is in HWPUNIT from the start of the paragraph.
+	Position int32
+
+	Kind TabKind
+
+	// Leader is the line filling the space up to the tab stop.
+	Leader LineType
+}
+
+// TabDef is a set of tab stops. Para shapes point at tab defs by their
+// index.
+//
+// TabDef는 탭 위치들의 묶음입니다. 문단 모양은 순번으로 탭 정의를
+// 가리킵니다.
+type TabDef struct {
+	Property uint32
+
+	Stops []TabStop
+}
+
+// AutoTabLeft reports if a tab is added at the left end of the paragraph
+// for hanging indents.
+func (td *TabDef) AutoTabLeft() bool {
+	return td.Property&(1<<0) != 0
+}
+
+// AutoTabRight reports if a tab is added at the right end of the
+// paragraph.
+func (td *TabDef) AutoTabRight() bool {
+	return td.Property&(1<<1) != 0
+}
+
+func (td *TabDef) deserialize(data []byte) error {
+	d := newDataReader(data)
+	td.Property = d.uint32()
+
+	// The spec says INT16 but files store the count as INT32.
+	n := int(d.int32())
+	if n < 0 || n > d.remaining()/8 {
+		// Let the reader report the bad count as truncated.
+		d.skip(8 * n)
+		return d.err
+	}
+
+	td.Stops = make([]TabStop, n)
+	for i := range td.Stops {
+		td.Stops[i] = TabStop{
+			Position: d.int32(),
+			Kind:     TabKind(d.uint8()),
+			Leader:   LineType(d.uint8()),
+		}
+		d.skip(2)
+	}
+
+	return d.err
+}
+
+func decodeTabDef(rec *Record, ver FileVersion) (interface{}, error) {
+	td := new(TabDef)
+	err := td.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return td, nil
+}
+
+func init() {
+	registerDecoder(TagTabDef, decodeTabDef)
+}
@@ -0,0 +1,227 @@
+package hwp50
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// tagBegin is HWPTAG_BEGIN. Every tag id in the spec is an offset from it.
+const tagBegin = 0x10
+
+// Tag ids of the DocInfo records.
+//
+// DocInfo 레코드들의 tag id 입니다.
+const (
+	TagDocumentProperties  TagID = tagBegin + 0
+	TagIDMappings          TagID = tagBegin + 1
+	TagBinData             TagID = tagBegin + 2
+	TagFaceName            TagID = tagBegin + 3
+	TagBorderFill          TagID = tagBegin + 4
+	TagCharShape           TagID = tagBegin + 5
+	TagTabDef              TagID = tagBegin + 6
+	TagNumbering           TagID = tagBegin + 7
+	TagBullet              TagID = tagBegin + 8
+	TagParaShape           TagID = tagBegin + 9
+	TagStyle               TagID = tagBegin + 10
+	TagDocData             TagID = tagBegin + 11
+	TagDistributeDocData   TagID = tagBegin + 12
+	TagCompatibleDocument  TagID = tagBegin + 14
+	TagLayoutCompatibility TagID = tagBegin + 15
+	TagTrackChange         TagID = tagBegin + 16
+	TagMemoShape           TagID = tagBegin + 76
+	TagForbiddenChar       TagID = tagBegin + 78
+	TagTrackChangeContent  TagID = tagBegin + 80
+	TagTrackChangeAuthor   TagID = tagBegin + 81
+)
+
+// Tag ids of the BodyText records.
+//
+// BodyText 레코드들의 tag id 입니다.
+const (
+	TagParaHeader              TagID = tagBegin + 50
+	TagParaText                TagID = tagBegin + 51
+	TagParaCharShape           TagID = tagBegin + 52
+	TagParaLineSeg             TagID = tagBegin + 53
+	TagParaRangeTag            TagID = tagBegin + 54
+	TagCtrlHeader              TagID = tagBegin + 55
+	TagListHeader              TagID = tagBegin + 56
+	TagPageDef                 TagID = tagBegin + 57
+	TagFootnoteShape           TagID = tagBegin + 58
+	TagPageBorderFill          TagID = tagBegin + 59
+	TagShapeComponent          TagID = tagBegin + 60
+	TagTable                   TagID = tagBegin + 61
+	TagShapeComponentLine      TagID = tagBegin + 62
+	TagShapeComponentRectangle TagID = tagBegin + 63
+	TagShapeComponentEllipse   TagID = tagBegin + 64
+	TagShapeComponentArc       TagID = tagBegin + 65
+	TagShapeComponentPolygon   TagID = tagBegin + 66
+	TagShapeComponentCurve     TagID = tagBegin + 67
+	TagShapeComponentOLE       TagID = tagBegin + 68
+	TagShapeComponentPicture   TagID = tagBegin + 69
+	TagShapeComponentContainer TagID = tagBegin + 70
+	TagCtrlData                TagID = tagBegin + 71
+	TagEqEdit                  TagID = tagBegin + 72
+	TagShapeComponentTextArt   TagID = tagBegin + 74
+	TagFormObject              TagID = tagBegin + 75
+	TagMemoList                TagID = tagBegin + 77
+	TagChartData               TagID = tagBegin + 79
+	TagVideoData               TagID = tagBegin + 82
+	TagShapeComponentUnknown   TagID = tagBegin + 99
+)
+
+// tagNames maps the tag ids to their names in the spec.
+var tagNames = map[TagID]string{
+	TagDocumentProperties:  "HWPTAG_DOCUMENT_PROPERTIES",
+	TagIDMappings:          "HWPTAG_ID_MAPPINGS",
+	TagBinData:             "HWPTAG_BIN_DATA",
+	TagFaceName:            "HWPTAG_FACE_NAME",
+	TagBorderFill:          "HWPTAG_BORDER_FILL",
+	TagCharShape:           "HWPTAG_CHAR_SHAPE",
+	TagTabDef:              "HWPTAG_TAB_DEF",
+	TagNumbering:           "HWPTAG_NUMBERING",
+	TagBullet:              "HWPTAG_BULLET",
+	TagParaShape:           "HWPTAG_PARA_SHAPE",
+	TagStyle:               "HWPTAG_STYLE",
+	TagDocData:             "HWPTAG_DOC_DATA",
+	TagDistributeDocData:   "HWPTAG_DISTRIBUTE_DOC_DATA",
+	TagCompatibleDocument:  "HWPTAG_COMPATIBLE_DOCUMENT",
+	TagLayoutCompatibility: "HWPTAG_LAYOUT_COMPATIBILITY",
+	TagTrackChange:         "HWPTAG_TRACKCHANGE",
+	TagMemoShape:           "HWPTAG_MEMO_SHAPE",
+	TagForbiddenChar:       "HWPTAG_FORBIDDEN_CHAR",
+	TagTrackChangeContent:  "HWPTAG_TRACK_CHANGE",
+	TagTrackChangeAuthor:   "HWPTAG_TRACK_CHANGE_AUTHOR",
+
+	TagParaHeader:              "HWPTAG_PARA_HEADER",
+	TagParaText:                "HWPTAG_PARA_TEXT",
+	TagParaCharShape:           "HWPTAG_PARA_CHAR_SHAPE",
+	TagParaLineSeg:             "HWPTAG_PARA_LINE_SEG",
+	TagParaRangeTag:            "HWPTAG_PARA_RANGE_TAG",
+	TagCtrlHeader:              "HWPTAG_CTRL_HEADER",
+	TagListHeader:              "HWPTAG_LIST_HEADER",
+	TagPageDef:                 "HWPTAG_PAGE_DEF",
+	TagFootnoteShape:           "HWPTAG_FOOTNOTE_SHAPE",
+	TagPageBorderFill:          "HWPTAG_PAGE_BORDER_FILL",
+	TagShapeComponent:          "HWPTAG_SHAPE_COMPONENT",
+	TagTable:                   "HWPTAG_TABLE",
+	TagShapeComponentLine:      "HWPTAG_SHAPE_COMPONENT_LINE",
+	TagShapeComponentRectangle: "HWPTAG_SHAPE_COMPONENT_RECTANGLE",
+	TagShapeComponentEllipse:   "HWPTAG_SHAPE_COMPONENT_ELLIPSE",
+	TagShapeComponentArc:       "HWPTAG_SHAPE_COMPONENT_ARC",
+	TagShapeComponentPolygon:   "HWPTAG_SHAPE_COMPONENT_POLYGON",
+	TagShapeComponentCurve:     "HWPTAG_SHAPE_COMPONENT_CURVE",
+	TagShapeComponentOLE:       "HWPTAG_SHAPE_COMPONENT_OLE",
+	TagShapeComponentPicture:   "HWPTAG_SHAPE_COMPONENT_PICTURE",
+	TagShapeComponentContainer: "HWPTAG_SHAPE_COMPONENT_CONTAINER",
+	TagCtrlData:                "HWPTAG_CTRL_DATA",
+	TagEqEdit:                  "HWPTAG_EQEDIT",
+	TagShapeComponentTextArt:   "HWPTAG_SHAPE_COMPONENT_TEXTART",
+	TagFormObject:              "HWPTAG_FORM_OBJECT",
+	TagMemoList:                "HWPTAG_MEMO_LIST",
+	TagChartData:               "HWPTAG_CHART_DATA",
+	TagVideoData:               "HWPTAG_VIDEO_DATA",
+	TagShapeComponentUnknown:   "HWPTAG_SHAPE_COMPONENT_UNKNOWN",
+}
+
+// String returns the name of the tag in the spec, e.g. "HWPTAG_PARA_TEXT".
+//
+// String은 스펙에 나온 tag의 이름을 반환합니다. 예: "HWPTAG_PARA_TEXT"
+func (t TagID) String() string {
+	name, ok := tagNames[t]
+	if !ok {
+		return fmt.Sprintf("HWPTAG_UNKNOWN(%d)", uint16(t))
+	}
+	return name
+}
+
+// Known reports if the tag is described in the spec.
+//
+// Known은 tag가 스펙에 나와 있는지를 뜻합니다.
+func (t TagID) Known() bool {
+	_, ok := tagNames[t]
+	return ok
+}
+
+// RecordDecoder decodes the data of a record into its typed form. ver is the
+// version of the file the record comes from so decoders can tell which
+// fields are present.
+//
+// RecordDecoder는 레코드의 데이터를 타입이 있는 형태로 decode 합니다.
+// ver는 레코드가 나온 파일의 버전이라 어떤 필드가 있는지 알 수 있습니다.
+type RecordDecoder func(rec *Record, ver FileVersion) (interface{}, error)
+
+// ErrNoDecoder is returned by DecodeRecord for tags without a registered
+// decoder.
+//
+// ErrNoDecoder는 등록된 decoder가 없는 tag에 대해 DecodeRecord가
+// 반환합니다.
+var ErrNoDecoder = errors.New("no decoder for record 레코드의 decoder가 없습니다")
+
+var (
+	// builtinDecoders are the decoders of the records the package decodes
+	// itself. They're only set by init functions, so reading them needs no
+	// lock.
+	builtinDecoders = make(map[TagID]RecordDecoder)
+
+	decodersMtx sync.RWMutex
+	decoders    = make(map[TagID]RecordDecoder)
+)
+
+// registerDecoder registers dec as the built-in decoder of the records with
+// the given tag.
+func registerDecoder(tag TagID, dec RecordDecoder) {
+	builtinDecoders[tag] = dec
+}
+
+// RegisterDecoder registers dec as the decoder of the records with the given
+// tag, replacing any previous one. The paragraphs and the DocInfo are built
+// from what the built-in decoders return, so they can't be replaced and
+// RegisterDecoder panics for a tag that has one.
+//
+// RegisterDecoder는 dec를 주어진 tag의 레코드 decoder로 등록합니다. 이미
+// 있던 decoder는 대체됩니다. 문단과 DocInfo는 내장 decoder의 결과로
+// 만들어지기 때문에 내장 decoder는 대체할 수 없으며, 내장 decoder가 있는
+// tag이면 panic 합니다.
+func RegisterDecoder(tag TagID, dec RecordDecoder) {
+	if _, ok := builtinDecoders[tag]; ok {
+		panic(fmt.Sprintf("hwp50: %v already has a built-in decoder", tag))
+	}
+
+	decodersMtx.Lock()
+	defer decodersMtx.Unlock()
+
+	decoders[tag] = dec
+}
+
+// DecodeRecord decodes rec with the built-in decoder of its tag or the one
+// registered for it.
+//
+// DecodeRecord는 rec의 tag의 내장 decoder나 등록된 decoder로 rec를 decode
+// 합니다.
+func DecodeRecord(rec *Record, ver FileVersion) (interface{}, error) {
+	dec, ok := builtinDecoders[rec.TagID]
+	if !ok {
+		decodersMtx.RLock()
+		dec, ok = decoders[rec.TagID]
+		decodersMtx.RUnlock()
+	}
+
+	if !ok {
+		return nil, &RecordError{
+			Offset: rec.Offset,
+			TagID:  rec.TagID,
+			Err:    ErrNoDecoder,
+		}
+	}
+
+	v, err := dec(rec, ver)
+	if err != nil {
+		return nil, &RecordError{
+			Offset: rec.Offset,
+			TagID:  rec.TagID,
+			Err:    err,
+		}
+	}
+	return v, nil
+}
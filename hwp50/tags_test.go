@@ -0,0 +1,83 @@
+package hwp50
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestTagIDString checks the spec names of a few tags.
+//
+// TestTagIDString은 몇몇 tag의 스펙 이름을 확인합니다.
+func TestTagIDString(t *testing.T) {
+	tests := []struct {
+		tag  TagID
+		name string
+	}{
+		{TagDocumentProperties, "HWPTAG_DOCUMENT_PROPERTIES"},
+		{TagLayoutCompatibility, "HWPTAG_LAYOUT_COMPATIBILITY"},
+		{TagTrackChangeAuthor, "HWPTAG_TRACK_CHANGE_AUTHOR"},
+		{TagParaHeader, "HWPTAG_PARA_HEADER"},
+		{TagShapeComponentArc, "HWPTAG_SHAPE_COMPONENT_ARC"},
+		{TagVideoData, "HWPTAG_VIDEO_DATA"},
+		{TagShapeComponentUnknown, "HWPTAG_SHAPE_COMPONENT_UNKNOWN"},
+		{TagID(1000), "HWPTAG_UNKNOWN(1000)"},
+	}
+
+	for _, test := range tests {
+		if got := test.tag.String(); got != test.name {
+			t.Errorf("tag %d: expected %s, got %s", uint16(test.tag),
+				test.name, got)
+		}
+	}
+
+	if TagID(1000).Known() {
+		t.Error("tag 1000 shouldn't be known")
+	}
+}
+
+// TestDecodeRecord checks that registered decoders are used and that a
+// missing one is reported with ErrNoDecoder.
+//
+// TestDecodeRecord는 등록된 decoder가 쓰이는지와 decoder가 없을 때
+// ErrNoDecoder가 반환되는지 확인합니다.
+func TestDecodeRecord(t *testing.T) {
+	const tag = TagID(1000)
+
+	_, err := DecodeRecord(&Record{TagID: tag}, FileVersion{})
+	if !errors.Is(err, ErrNoDecoder) {
+		t.Fatalf("expected ErrNoDecoder, got %v", err)
+	}
+
+	RegisterDecoder(tag, func(rec *Record, ver FileVersion) (interface{}, error) {
+		return len(rec.Data), nil
+	})
+	defer func() {
+		decodersMtx.Lock()
+		delete(decoders, tag)
+		decodersMtx.Unlock()
+	}()
+
+	v, err := DecodeRecord(&Record{TagID: tag, Data: make([]byte, 3)}, FileVersion{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.(int) != 3 {
+		t.Errorf("expected 3, got %v", v)
+	}
+}
+
+// TestRegisterBuiltinDecoder checks that a built-in decoder can't be
+// replaced.
+//
+// TestRegisterBuiltinDecoder는 내장 decoder를 대체할 수 없는지 확인합니다.
+func TestRegisterBuiltinDecoder(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+
+	RegisterDecoder(TagParaHeader, func(rec *Record, ver FileVersion) (interface{}, error) {
+		return nil, nil
+	})
+}
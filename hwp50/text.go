@@ -0,0 +1,205 @@
+package hwp50
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// TextOptions chooses which parts of the document the text extraction
+// descends into. The body text is always included.
+//
+// TextOptions는 텍스트 추출 시 어떤 부분까지 들어갈지를 정합니다. 본문은
+// 항상 포함됩니다.
+type TextOptions struct {
+	// Tables includes the paragraphs of table cells.
+	Tables bool
+
+	// TextBoxes includes the paragraphs of text boxes in drawing objects.
+	TextBoxes bool
+
+	// HeadersFooters includes the paragraphs of headers and footers.
+	HeadersFooters bool
+
+	// Notes includes the paragraphs of footnotes and endnotes.
+	Notes bool
+
+	// Numbers puts the outline number, paragraph number or bullet before
+	// the paragraphs that have one. It's off by default so the text stays
+	// what the paragraphs hold.
+	Numbers bool
+}
+
+// DefaultTextOptions is what Text and WriteText use. Headers and footers
+// are left out as they repeat on every page.
+//
+// DefaultTextOptions는 Text와 WriteText가 쓰는 설정입니다. 머리말과
+// 꼬리말은 쪽마다 반복되므로 빠집니다.
+var DefaultTextOptions = TextOptions{
+	Tables:    true,
+	TextBoxes: true,
+	Notes:     true,
+}
+
+// Text returns the text of the document with DefaultTextOptions.
+//
+// Text는 DefaultTextOptions로 문서의 텍스트를 반환합니다.
+func (hwp *Hwp) Text() (string, error) {
+	var b strings.Builder
+	err := hwp.WriteText(&b)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// WriteText writes the text of the document to w with DefaultTextOptions.
+//
+// WriteText는 DefaultTextOptions로 문서의 텍스트를 w에 씁니다.
+func (hwp *Hwp) WriteText(w io.Writer) error {
+	return hwp.WriteTextOptions(w, DefaultTextOptions)
+}
+
+// WriteTextOptions writes the text of the document to w, one paragraph per
+// line. The paragraphs of the controls chosen by opts follow the paragraph
+// the control is in.
+//
+// WriteTextOptions는 문서의 텍스트를 문단마다 한 줄씩 w에 씁니다. opts로
+// 고른 컨트롤의 문단들은 컨트롤이 들어있는 문단 뒤에 나옵니다.
+func (hwp *Hwp) WriteTextOptions(w io.Writer, opts TextOptions) error {
+	tw := &textWriter{
+		w:    bufio.NewWriter(w),
+		opts: opts,
+		ver:  hwp.FileHeader.Version,
+	}
+	if opts.Numbers {
+		tw.numberer = NewNumberer(&hwp.DocInfo)
+	}
+
+	for _, section := range hwp.sections() {
+		if tw.numberer != nil {
+			tw.numberer.SetOutline(section.outlineNumbering())
+		}
+		for _, p := range section.Paragraphs {
+			err := tw.paragraph(p)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tw.w.Flush()
+}
+
+// sections returns the sections of the document. If DocumentProperites
+// declares how many sections there are, streams past that are left out.
+func (hwp *Hwp) sections() []BodyText {
+	n := int(hwp.DocInfo.DocumentProperites.SectionNum)
+	if n == 0 || n > len(hwp.BodyText) {
+		return hwp.BodyText
+	}
+	return hwp.BodyText[:n]
+}
+
+// outlineNumbering returns the numbering id of the outline headings of the
+// section, which its section definition holds. It's 0 if there's none.
+func (bt *BodyText) outlineNumbering() uint16 {
+	if len(bt.Paragraphs) == 0 {
+		return 0
+	}
+	for _, run := range bt.Paragraphs[0].Runs {
+		if sd, ok := run.Control.(*SectionDef); ok {
+			return sd.OutlineNumberingID
+		}
+	}
+	return 0
+}
+
+// textWriter writes the text of paragraphs and the controls in them.
+type textWriter struct {
+	w    *bufio.Writer
+	opts TextOptions
+	ver  FileVersion
+
+	// numberer is set when opts.Numbers is.
+	numberer *Numberer
+}
+
+func (tw *textWriter) paragraph(p *Paragraph) error {
+	// Numbers are best effort. A paragraph pointing at a shape that
+	// doesn't exist still has its text written.
+	if tw.numberer != nil {
+		label, err := tw.numberer.Label(p)
+		if err == nil && label != "" {
+			tw.w.WriteString(label)
+			tw.w.WriteByte(' ')
+		}
+	}
+
+	tw.w.WriteString(p.Text())
+	tw.w.WriteByte('\n')
+
+	for _, run := range p.Runs {
+		if run.Kind != RunExtendedControl || run.CtrlHeader == nil ||
+			!tw.includes(run.CtrlID) {
+			continue
+		}
+
+		paras, err := controlParagraphs(run.CtrlHeader, tw.ver)
+		if err != nil {
+			return err
+		}
+		for _, p := range paras {
+			err = tw.paragraph(p)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// includes reports if the paragraphs of the control id are wanted.
+func (tw *textWriter) includes(id CtrlID) bool {
+	switch id {
+	case CtrlIDTable:
+		return tw.opts.Tables
+	case CtrlIDShapeObject:
+		return tw.opts.TextBoxes
+	case CtrlIDHeader, CtrlIDFooter:
+		return tw.opts.HeadersFooters
+	case CtrlIDFootnote, CtrlIDEndnote:
+		return tw.opts.Notes
+	}
+	return false
+}
+
+// controlParagraphs decodes the paragraphs held by a control. They may sit
+// right under the CTRL_HEADER, as with table cells, or deeper, as with the
+// text box of a shape. Paragraphs nested in those paragraphs are left to
+// the paragraphs themselves.
+func controlParagraphs(ctrl *RecordNode, ver FileVersion) ([]*Paragraph, error) {
+	var paras []*Paragraph
+	var walk func(nodes []*RecordNode) error
+	walk = func(nodes []*RecordNode) error {
+		for _, node := range nodes {
+			if node.TagID != TagParaHeader {
+				err := walk(node.Children)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			p, err := newParagraph(node, ver)
+			if err != nil {
+				return err
+			}
+			paras = append(paras, p)
+		}
+		return nil
+	}
+
+	err := walk(ctrl.Children)
+	return paras, err
+}
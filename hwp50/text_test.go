@@ -0,0 +1,105 @@
+package hwp50
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// testParagraph returns the records of a paragraph at level with the given
+// text followed by the given controls.
+func testParagraph(level uint16, text string, ctrls ...[]*Record) []*Record {
+	units := utf16.Encode([]rune(text))
+	for _, ctrl := range ctrls {
+		id := CtrlID(binary.LittleEndian.Uint32(ctrl[0].Data))
+		units = append(units, control(11, id)...)
+	}
+	units = append(units, 13)
+
+	records := []*Record{
+		{TagID: TagParaHeader, Level: level, Data: make([]byte, 22)},
+		{TagID: TagParaText, Level: level + 1, Data: paraText(units...)},
+	}
+	for _, ctrl := range ctrls {
+		records = append(records, ctrl...)
+	}
+	return records
+}
+
+// testControl returns the records of a control at level holding one list
+// made of the given paragraphs, which must be at level+1. The data past the
+// ctrl id is zero and long enough for the object properties of tables.
+func testControl(level uint16, id CtrlID, paras ...[]*Record) []*Record {
+	data := make([]byte, 46)
+	binary.LittleEndian.PutUint32(data, uint32(id))
+
+	records := []*Record{
+		{TagID: TagCtrlHeader, Level: level, Data: data},
+		{TagID: TagListHeader, Level: level + 1},
+	}
+	for _, p := range paras {
+		records = append(records, p...)
+	}
+	return records
+}
+
+// TestWriteTextOptions extracts the text of a section with a table, a nested
+// table, a footnote and a header.
+//
+// TestWriteTextOptions는 표, 중첩 표, 각주, 머리말이 있는 구역의 텍스트를
+// 추출합니다.
+func TestWriteTextOptions(t *testing.T) {
+	inner := testControl(3, CtrlIDTable, testParagraph(4, "inner"))
+	table := testControl(1, CtrlIDTable,
+		testParagraph(2, "cell", inner), testParagraph(2, "cell2"))
+
+	var records []*Record
+	records = append(records, testParagraph(0, "first", table)...)
+	records = append(records, testParagraph(0, "second",
+		testControl(1, CtrlIDFootnote, testParagraph(2, "note")),
+		testControl(1, CtrlIDHeader, testParagraph(2, "header")))...)
+
+	paras, err := paragraphs(BuildRecordTree(records), FileVersion{5, 0, 4, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hwp := &Hwp{BodyText: []BodyText{{Paragraphs: paras}}}
+
+	tests := []struct {
+		opts TextOptions
+		want string
+	}{
+		{DefaultTextOptions, "first\ncell\ninner\ncell2\nsecond\nnote\n"},
+		{TextOptions{}, "first\nsecond\n"},
+		{TextOptions{HeadersFooters: true}, "first\nsecond\nheader\n"},
+	}
+	for _, test := range tests {
+		var b strings.Builder
+		err := hwp.WriteTextOptions(&b, test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != test.want {
+			t.Errorf("%+v: expected %q, got %q", test.opts, test.want, b.String())
+		}
+	}
+}
+
+// TestTextTestdata extracts the text of the testdata file.
+//
+// TestTextTestdata는 testdata 파일의 텍스트를 추출합니다.
+func TestTextTestdata(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text, err := hwp.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "test\n" {
+		t.Errorf("expected %q, got %q", "test\n", text)
+	}
+}
@@ -0,0 +1,85 @@
+package hwp50
+
+// The spec only names the track change records. What's decoded here
+// follows the trackChangeConfig and trackChangeAuthor elements of HWPX,
+// which store the same data. Bytes past the known fields are kept in
+// Undefined.
+//
+// 스펙에는 변경 추적 레코드의 이름만 있습니다. 여기서는 같은 내용을 담는
+// HWPX의 trackChangeConfig, trackChangeAuthor 요소를 따라 읽고, 알려지지
+// 않은 나머지 바이트는 Undefined에 둡니다.
+
+// TrackChangeConfig holds the track change settings of the document.
+//
+// TrackChangeConfig는 문서의 변경 추적 설정입니다.
+type TrackChangeConfig struct {
+	Flags uint32
+
+	Undefined []byte
+}
+
+func (tc *TrackChangeConfig) deserialize(data []byte) error {
+	d := newDataReader(data)
+	tc.Flags = d.uint32()
+	tc.Undefined = d.bytes(d.remaining())
+	return d.err
+}
+
+func decodeTrackChangeConfig(rec *Record, ver FileVersion) (interface{}, error) {
+	tc := new(TrackChangeConfig)
+	err := tc.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return tc, nil
+}
+
+// TrackChange is a change made while changes were tracked. Nothing says
+// how its record is laid out, so the data is kept as it is.
+//
+// TrackChange는 변경 추적 중에 생긴 변경 하나입니다. 레코드 형식이
+// 알려져 있지 않아서 데이터를 그대로 둡니다.
+type TrackChange struct {
+	Undefined []byte
+}
+
+func decodeTrackChange(rec *Record, ver FileVersion) (interface{}, error) {
+	return &TrackChange{Undefined: append([]byte(nil), rec.Data...)}, nil
+}
+
+// TrackChangeAuthor is someone who made tracked changes.
+//
+// TrackChangeAuthor는 추적된 변경을 만든 사람입니다.
+type TrackChangeAuthor struct {
+	Name string
+
+	// Color is the color the changes of the author are marked in.
+	Color ColorRef
+
+	Undefined []byte
+}
+
+func (a *TrackChangeAuthor) deserialize(data []byte) error {
+	d := newDataReader(data)
+	a.Name = d.string()
+	if d.remaining() >= 4 {
+		a.Color = ColorRef(d.uint32())
+	}
+	a.Undefined = d.bytes(d.remaining())
+	return d.err
+}
+
+func decodeTrackChangeAuthor(rec *Record, ver FileVersion) (interface{}, error) {
+	a := new(TrackChangeAuthor)
+	err := a.deserialize(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func init() {
+	registerDecoder(TagTrackChange, decodeTrackChangeConfig)
+	registerDecoder(TagTrackChangeContent, decodeTrackChange)
+	registerDecoder(TagTrackChangeAuthor, decodeTrackChangeAuthor)
+}
@@ -0,0 +1,89 @@
+package hwp50
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestTrackChangeConfig checks the track change settings of the testdata
+// file.
+//
+// TestTrackChangeConfig는 testdata 파일의 변경 추적 설정을 확인합니다.
+func TestTrackChangeConfig(t *testing.T) {
+	hwp, err := OpenFile("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc := hwp.DocInfo.TrackChangeConfig
+	if tc.Flags != 0x38 || len(tc.Undefined) != 1028 {
+		t.Errorf("unexpected config %#x with %d more bytes", tc.Flags,
+			len(tc.Undefined))
+	}
+	if len(hwp.DocInfo.MemoShape) != 0 || len(hwp.DocInfo.TrackChanges) != 0 ||
+		len(hwp.DocInfo.TrackChangeAuthor) != 0 {
+		t.Error("expected no memo shapes and tracked changes")
+	}
+}
+
+// TestDecodeTrackChanges decodes a memo shape, a change and an author
+// counted by the ID_MAPPINGS record.
+//
+// TestDecodeTrackChanges는 ID_MAPPINGS 레코드가 세는 메모 모양, 변경,
+// 변경한 사람을 읽습니다.
+func TestDecodeTrackChanges(t *testing.T) {
+	counts := make([]int32, 18)
+	counts[15], counts[16], counts[17] = 1, 1, 1
+	records := []*Record{
+		{TagID: TagIDMappings, Data: littleEndian(counts)},
+		{TagID: TagMemoShape, Data: littleEndian(uint32(15591), uint8(7),
+			LineSolid, uint32(0xaed7b6), uint32(0xe9fff0), uint32(0xc7f1cf),
+			MemoNormal)},
+		{TagID: TagTrackChangeContent, Data: []byte{2, 0, 0, 0, 0xe6, 7}},
+		{TagID: TagTrackChangeAuthor, Data: littleEndian(uint16(3),
+			[]uint16{'홍', '길', '동'}, uint32(0xff0000), uint16(1))},
+	}
+
+	var di DocInfo
+	err := di.decode(records, FileVersion{5, 0, 3, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(di.MemoShape) != 1 {
+		t.Fatalf("expected 1 memo shape, got %d", len(di.MemoShape))
+	}
+	ms := di.MemoShape[0]
+	if ms.Width != 15591 || ms.LineThickness != 7 ||
+		ms.LineType != LineSolid || ms.LineColor.String() != "#b6d7ae" ||
+		ms.FillColor.String() != "#f0ffe9" || ms.Kind != MemoNormal {
+		t.Errorf("unexpected memo shape %+v", ms)
+	}
+
+	if len(di.TrackChanges) != 1 {
+		t.Fatalf("expected 1 change, got %d", len(di.TrackChanges))
+	}
+	// The layout of the change is unknown, so it's kept as it is.
+	// 변경의 형식은 알려져 있지 않아서 그대로 둡니다.
+	if !bytes.Equal(di.TrackChanges[0].Undefined, records[2].Data) {
+		t.Errorf("unexpected change %+v", di.TrackChanges[0])
+	}
+
+	if len(di.TrackChangeAuthor) != 1 {
+		t.Fatalf("expected 1 author, got %d", len(di.TrackChangeAuthor))
+	}
+	author := di.TrackChangeAuthor[0]
+	if author.Name != "홍길동" || author.Color.String() != "#0000ff" ||
+		len(author.Undefined) != 2 {
+		t.Errorf("unexpected author %+v", author)
+	}
+
+	// A change the ID_MAPPINGS record doesn't count is an error.
+	// ID_MAPPINGS 레코드가 세지 않은 변경은 오류입니다.
+	records = append(records, records[2])
+	err = new(DocInfo).decode(records, FileVersion{5, 0, 3, 2})
+	if !errors.Is(err, ErrRecordCount) {
+		t.Errorf("expected ErrRecordCount, got %v", err)
+	}
+}
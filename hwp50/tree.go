@@ -0,0 +1,110 @@
+package hwp50
+
+import "io"
+
+// RecordNode is a record along with the records that belong to it. A record
+// belongs to the closest previous record with a smaller level, so e.g. the
+// PARA_TEXT of a paragraph is a child of its PARA_HEADER and the cells of a
+// table are children of the table's CTRL_HEADER.
+//
+// RecordNode는 레코드와 그 레코드에 속한 레코드들입니다. 레코드는 level이
+// 더 작은 가장 가까운 앞 레코드에 속합니다. 예를 들어 문단의 PARA_TEXT는
+// PARA_HEADER의 자식이고, 표의 셀들은 표 CTRL_HEADER의 자식입니다.
+type RecordNode struct {
+	*Record
+
+	Children []*RecordNode
+}
+
+// BuildRecordTree assembles records into trees by their levels and returns
+// the roots. Records whose level skips ahead of their parent's are still
+// attached to the closest record with a smaller level.
+//
+// BuildRecordTree는 레코드들을 level에 따라 트리로 묶고 루트들을
+// 반환합니다.
+func BuildRecordTree(records []*Record) []*RecordNode {
+	var (
+		roots []*RecordNode
+		stack []*RecordNode
+	)
+
+	for _, rec := range records {
+		node := &RecordNode{Record: rec}
+
+		// Pop until the top of the stack is the parent of node.
+		// 스택 맨 위가 node의 부모가 될 때까지 pop 합니다.
+		for len(stack) > 0 && stack[len(stack)-1].Level >= rec.Level {
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) == 0 {
+			roots = append(roots, node)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, node)
+		}
+		stack = append(stack, node)
+	}
+
+	return roots
+}
+
+// ReadRecordTree reads every record from r and assembles them into trees.
+//
+// ReadRecordTree는 r의 모든 레코드를 읽어 트리로 묶습니다.
+func ReadRecordTree(r io.Reader) ([]*RecordNode, error) {
+	records, err := ReadRecords(r)
+	if err != nil {
+		return nil, err
+	}
+	return BuildRecordTree(records), nil
+}
+
+// Child returns the first child of n with the given tag or nil.
+//
+// Child는 주어진 tag를 가진 n의 첫 번째 자식을 반환합니다. 없으면 nil을
+// 반환합니다.
+func (n *RecordNode) Child(tag TagID) *RecordNode {
+	for _, child := range n.Children {
+		if child.TagID == tag {
+			return child
+		}
+	}
+	return nil
+}
+
+// ChildrenOf returns the children of n with the given tag in order.
+//
+// ChildrenOf는 주어진 tag를 가진 n의 자식들을 순서대로 반환합니다.
+func (n *RecordNode) ChildrenOf(tag TagID) []*RecordNode {
+	var children []*RecordNode
+	for _, child := range n.Children {
+		if child.TagID == tag {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// Walk calls fn for n and every node below it in depth first order. depth
+// is 0 for n. Walk stops at the first error fn returns.
+//
+// Walk는 n과 그 아래의 모든 노드에 대해 깊이 우선 순서로 fn을 부릅니다.
+// fn이 에러를 반환하면 멈춥니다.
+func (n *RecordNode) Walk(fn func(node *RecordNode, depth int) error) error {
+	return n.walk(fn, 0)
+}
+
+func (n *RecordNode) walk(fn func(node *RecordNode, depth int) error, depth int) error {
+	err := fn(n, depth)
+	if err != nil {
+		return err
+	}
+	for _, child := range n.Children {
+		err = child.walk(fn, depth+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
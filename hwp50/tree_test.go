@@ -0,0 +1,69 @@
+package hwp50
+
+import "testing"
+
+// TestBuildRecordTree nests a table inside a table cell and checks that
+// every record ends up under the right parent.
+//
+// TestBuildRecordTree는 표 셀 안에 표를 넣고 모든 레코드가 알맞은 부모
+// 아래에 있는지 확인합니다.
+func TestBuildRecordTree(t *testing.T) {
+	levels := []struct {
+		tag   TagID
+		level uint16
+	}{
+		{TagParaHeader, 0},
+		{TagParaText, 1},
+		{TagCtrlHeader, 1}, // 'tbl '
+		{TagTable, 2},
+		{TagListHeader, 2}, // cell
+		{TagParaHeader, 2},
+		{TagCtrlHeader, 3}, // 'tbl '
+		{TagListHeader, 4}, // cell
+		{TagParaHeader, 4},
+		{TagParaText, 5},
+		{TagParaHeader, 2},
+		{TagParaHeader, 0},
+	}
+
+	var records []*Record
+	for _, l := range levels {
+		records = append(records, &Record{TagID: l.tag, Level: l.level})
+	}
+
+	roots := BuildRecordTree(records)
+	if len(roots) != 2 {
+		t.Fatalf("expected 2 roots, got %d", len(roots))
+	}
+
+	ctrl := roots[0].Child(TagCtrlHeader)
+	if ctrl == nil || len(ctrl.Children) != 4 {
+		t.Fatalf("expected the table to have 4 children")
+	}
+	if len(ctrl.ChildrenOf(TagParaHeader)) != 2 {
+		t.Errorf("expected 2 paragraphs in the outer table")
+	}
+
+	inner := ctrl.Children[2].Child(TagCtrlHeader)
+	if inner == nil || len(inner.Children) != 2 {
+		t.Fatalf("expected the inner table to have 2 children")
+	}
+	if inner.Children[1].Child(TagParaText) == nil {
+		t.Errorf("expected the inner cell paragraph to have text")
+	}
+
+	var count int
+	for _, root := range roots {
+		root.Walk(func(node *RecordNode, depth int) error {
+			if int(node.Level) != depth {
+				t.Errorf("record at offset %d has level %d but depth %d",
+					node.Offset, node.Level, depth)
+			}
+			count++
+			return nil
+		})
+	}
+	if count != len(records) {
+		t.Errorf("walked %d records, expected %d", count, len(records))
+	}
+}
@@ -1,47 +1,166 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+
+	"github.com/goodhangul/hwp50"
 )
 
-var msg = `
-Usage: goodhangul FILENAME [OPTION]
+var msg = `Usage: goodhangul COMMAND [OPTION]... FILE
 A converter for hwp files
 
-OPTIONS:
-  xml	parse and output the file to xml
-  pdf	parse and output the file to pdf
+COMMANDS:
+  info		print the properties of the file
+  text		print the text of the file
+  convert	convert the file to another format
+  extract	extract a stream, or the pictures and OLE objects, of the file
+  dump		print the records of a stream
+  validate	check that the files can be parsed
+
+FILE can be - to read from stdin.
+Run 'goodhangul COMMAND -h' for the options of a command.
+
+EXIT STATUS:
+  0	success
+  1	any other error
+  2	bad command line
+  3	file not found
+  4	not a hwp file or unsupported format
 `
 
-// bit of a hack. Stdandard flag lib doesn't allow flag.Parse(os.Args[2]). You need a subcommand to do so.
-var optionCmd = flag.NewFlagSet("", flag.ExitOnError)
+// Exit codes so scripts can tell failures apart.
+const (
+	exitOK          = 0
+	exitError       = 1 // anything not below
+	exitUsage       = 2 // bad command line
+	exitNotFound    = 3 // the file doesn't exist
+	exitUnsupported = 4 // not a hwp file or a format we can't handle
+)
+
+// errUnsupported is returned for formats goodhangul can't handle yet.
+var errUnsupported = errors.New("unsupported format")
+
+// command is a subcommand of goodhangul.
+type command struct {
+	// usage is the synopsis of the command shown by -h.
+	usage string
+
+	// run parses args with fs and runs the command.
+	run func(fs *flag.FlagSet, args []string) error
+}
+
+var commands = map[string]command{
+	"info":     {"info [-json] [-o OUTPUT] FILE", runInfo},
+	"text":     {"text [-tables=false] [-textboxes=false] [-headers] [-notes=false] [-numbers] [-o OUTPUT] FILE", runText},
+	"convert":  {"convert -to FORMAT [-o OUTPUT] FILE", runConvert},
+	"extract":  {"extract [-list] [-stream NAME] [-bindata DIR] [-o OUTPUT] FILE", runExtract},
+	"dump":     {"dump [-stream NAME] [-data] [-o OUTPUT] FILE", runDump},
+	"validate": {"validate FILE...", runValidate},
+}
 
 func main() {
-	//check if enough arguments were given
-	if len(os.Args) < 2 {
-		fmt.Println(msg)
-		os.Exit(1)
+	os.Exit(run(os.Args[1:]))
+}
+
+// run runs the command in args and returns the exit code.
+func run(args []string) int {
+	if len(args) < 1 {
+		fmt.Fprint(os.Stderr, msg)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "-h", "-help", "--help", "help":
+		fmt.Print(msg)
+		return exitOK
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "goodhangul: unknown command %q\n", args[0])
+		fmt.Fprint(os.Stderr, msg)
+		return exitUsage
 	}
 
-	err := optionCmd.Parse(os.Args[0:])
-	if err != nil {
-		fmt.Println(msg)
-		os.Exit(1)
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: goodhangul %s\n", cmd.usage)
+		fs.SetOutput(os.Stderr)
+		fs.PrintDefaults()
+		fs.SetOutput(io.Discard)
 	}
 
-	fileName := os.Args[1]
+	err := cmd.run(fs, args[1:])
+	if err == nil {
+		return exitOK
+	}
 
-	f, err := os.Open(fileName)
-	if err != nil {
-		fmt.Println(msg)
-		os.Exit(1)
+	// The flag package already printed the usage for -h.
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
 	}
-	err = Parse(f)
-	if err != nil && err != io.EOF {
-		fmt.Println(msg)
-		os.Exit(1)
+
+	fmt.Fprintf(os.Stderr, "goodhangul %s: %v\n", args[0], err)
+	return exitCode(err)
+}
+
+// usageError is a bad command line.
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string { return e.err.Error() }
+func (e usageError) Unwrap() error { return e.err }
+
+// exitCode maps an error to the exit code it should end goodhangul with.
+func exitCode(err error) int {
+	var uerr usageError
+	switch {
+	case errors.As(err, &uerr):
+		return exitUsage
+	case errors.Is(err, os.ErrNotExist):
+		return exitNotFound
+	case errors.Is(err, errUnsupported),
+		errors.Is(err, hwp50.ErrNotCompoundFile),
+		errors.Is(err, hwp50.ErrBadSignature),
+		errors.Is(err, hwp50.ErrMissingFileHeader),
+		errors.Is(err, hwp50.ErrIncompatibleVersion):
+		return exitUnsupported
+	}
+	return exitError
+}
+
+// parseArgs parses the flags in args with fs, allowing flags after the
+// positional arguments too, and returns the positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err == flag.ErrHelp {
+			return nil, err
+		}
+		if err != nil {
+			return nil, usageError{err}
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// oneFile returns the only file in args.
+func oneFile(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", usageError{fmt.Errorf("expected one FILE, got %d", len(args))}
 	}
+	return args[0], nil
 }
@@ -1,31 +1,111 @@
 package main
 
 import (
-	"fmt"
+	"bytes"
+	"io"
 	"os"
 
-	"github.com/richardlehane/mscfb"
+	"github.com/goodhangul/hwp50"
 )
 
-// Parse parses a given hwp file
-func Parse(f *os.File) error {
-	doc, err := mscfb.New(f)
+// stdio is the file name that stands for stdin or stdout.
+const stdio = "-"
+
+// Parse parses the hwp file at fileName. stdio reads the file from stdin.
+func Parse(fileName string) (*hwp50.Hwp, error) {
+	r, size, err := openInput(fileName)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer r.Close()
 
-	// weird c style for loop
-	for entry, err := doc.Next(); err == nil; entry, err = doc.Next() {
+	return hwp50.Open(r, size)
+}
 
-		buf := make([]byte, 512)
-		i, _ := doc.Read(buf)
-		fmt.Println(entry.Name)
-		if i > 0 {
-			fmt.Println(buf[:i])
+// ParseHeader reads only the FileHeader of the hwp file at fileName, which
+// works even for files the rest of goodhangul can't parse.
+func ParseHeader(fileName string) (*hwp50.FileHeader, error) {
+	r, _, err := openInput(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	fh := new(hwp50.FileHeader)
+	err = fh.GrabFileHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	return fh, nil
+}
+
+// input is a file opened for random access.
+type input interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// nopReaderAt is stdin read into memory.
+type nopReaderAt struct {
+	*bytes.Reader
+}
+
+func (nopReaderAt) Close() error { return nil }
+
+// openInput opens the file at fileName and returns its size. stdio reads
+// stdin whole first as compound files need random access.
+func openInput(fileName string) (input, int64, error) {
+	if fileName == stdio {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, 0, err
 		}
+		return nopReaderAt{bytes.NewReader(data)}, int64(len(data)), nil
 	}
 
-	// err from the for loop is caught here
-	// EOF is also returned as an error
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, 0, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, fi.Size(), nil
+}
+
+// nopWriteCloser keeps stdout open when the output is closed.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// createOutput opens the output file at fileName. An empty name or stdio
+// writes to stdout.
+func createOutput(fileName string) (io.WriteCloser, error) {
+	if fileName == "" || fileName == stdio {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+	return os.Create(fileName)
+}
+
+// writeOutput calls write with the output file at fileName and closes it.
+// If writing or closing fails, the partly written file is removed.
+func writeOutput(fileName string, write func(w io.Writer) error) error {
+	w, err := createOutput(fileName)
+	if err != nil {
+		return err
+	}
+
+	err = write(w)
+	cerr := w.Close()
+	if err == nil {
+		err = cerr
+	}
+	if err != nil && fileName != "" && fileName != stdio {
+		os.Remove(fileName)
+	}
 	return err
 }